@@ -1,9 +1,9 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"text/tabwriter"
 
 	"github.com/nspcc-dev/neofs-api-go/accounting"
@@ -39,17 +39,25 @@ func getBalance(c *cli.Context) error {
 		return err
 	}
 
+	resp, err := requestBalance(ctx, c, conn, owner)
+	if err != nil {
+		return err
+	}
+
+	return printOutput(c, balanceOutputFrom(resp), func(w io.Writer) error {
+		return displayBalance(w, resp)
+	})
+}
+
+func requestBalance(ctx context.Context, c *cli.Context, conn *grpc.ClientConn, owner refs.OwnerID) (*accounting.BalanceResponse, error) {
 	req := &accounting.BalanceRequest{OwnerID: owner}
 	setTTL(c, req)
 	setRaw(c, req)
 	signRequest(c, req)
 
 	resp, err := accounting.NewAccountingClient(conn).Balance(ctx, req)
-	if err != nil {
-		return errors.Wrap(err, "could not request balance")
-	}
 
-	return displayBalance(os.Stdout, resp)
+	return resp, errors.Wrap(err, "could not request balance")
 }
 
 func displayBalance(wr io.Writer, resp *accounting.BalanceResponse) error {
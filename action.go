@@ -10,13 +10,28 @@ const (
 	_ actionName = iota
 	Global
 
+	Profile
+	ListProfiles
+	UseProfile
+	ShowProfile
+	DeleteProfile
+
+	Key
+	GenerateKey
+	InfoKey
+	ConvertKey
+
+	Hash
+
 	Container
 	PutContainer
+	ApplyContainer
 	GetContainer
 	DelContainer
 	ListContainers
 	SetContainerEACL
 	GetContainerEACL
+	SimulateContainer
 
 	Object
 	GetObject
@@ -32,12 +47,19 @@ const (
 	PutStorageGroup
 	ListStorageGroups
 	DeleteStorageGroup
+	VerifyStorageGroup
+
+	Bearer
+	CreateBearer
+	InspectBearer
 
 	Withdraw
 	PutWithdraw
 	GetWithdraw
 	DelWithdraw
 	ListWithdraw
+	VerifyWithdraw
+	ExportWithdraw
 
 	Accounting
 	BalanceAccounting
@@ -59,12 +81,33 @@ type action struct {
 
 var actions = map[actionName]*action{
 	Global: {
-		Flags: []cli.Flag{ttlF, rawQuery, cfgF, keyFile, hostAddr, verbose, extHeader},
+		Flags: []cli.Flag{
+			ttlF, rawQuery, cfgF, profileF, keyFile, walletAddress, passphraseFD, hostAddr, dialTimeout,
+			tlsF, caCert, clientCert, clientKey, insecureSkipVerify,
+			verbose, extHeader, outputFormat,
+		},
 	},
 
+	// profile commands
+	Profile:       profileAction,
+	ListProfiles:  listProfilesAction,
+	UseProfile:    useProfileAction,
+	ShowProfile:   showProfileAction,
+	DeleteProfile: deleteProfileAction,
+
+	// key commands
+	Key:         keyAction,
+	GenerateKey: generateKeyAction,
+	InfoKey:     infoKeyAction,
+	ConvertKey:  convertKeyAction,
+
+	// hash commands
+	Hash: hashAction,
+
 	// container commands
 	Container:      containerAction,
 	PutContainer:   putContainerAction,
+	ApplyContainer: applyContainerAction,
 	GetContainer:   getContainerAction,
 	DelContainer:   delContainerAction,
 	ListContainers: listContainersAction,
@@ -72,6 +115,8 @@ var actions = map[actionName]*action{
 	SetContainerEACL: setContainerEACLAction,
 	GetContainerEACL: getContainerEACLAction,
 
+	SimulateContainer: simulateContainerAction,
+
 	// object commands
 	Object:             objectAction,
 	GetObject:          getObjectAction,
@@ -87,13 +132,21 @@ var actions = map[actionName]*action{
 	PutStorageGroup:    putSGAction,
 	ListStorageGroups:  listSGAction,
 	DeleteStorageGroup: delSGAction,
+	VerifyStorageGroup: verifySGAction,
+
+	// bearer token commands
+	Bearer:        bearerAction,
+	CreateBearer:  createBearerAction,
+	InspectBearer: inspectBearerAction,
 
 	// withdrawal commands
-	Withdraw:     withdrawAction,
-	PutWithdraw:  putWithdrawAction,
-	GetWithdraw:  getWithdrawAction,
-	DelWithdraw:  delWithdrawAction,
-	ListWithdraw: listWithdrawAction,
+	Withdraw:       withdrawAction,
+	PutWithdraw:    putWithdrawAction,
+	GetWithdraw:    getWithdrawAction,
+	DelWithdraw:    delWithdrawAction,
+	ListWithdraw:   listWithdrawAction,
+	VerifyWithdraw: verifyWithdrawAction,
+	ExportWithdraw: exportWithdrawAction,
 
 	// accounting commands
 	Accounting:        accountingAction,
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	waitFlag   = "wait"
+	noWaitFlag = "no-wait"
+
+	// notConfirmedExitCode is returned when request was accepted by the node,
+	// but its result wasn't observed in the network in time.
+	notConfirmedExitCode = 4
+
+	awaitInitialInterval = 500 * time.Millisecond
+	awaitMaxInterval     = 10 * time.Second
+)
+
+var errNotConfirmed = errors.New("request submitted but not confirmed")
+
+var (
+	waitRequest = &cli.BoolFlag{
+		Name:  waitFlag,
+		Usage: "await until request is confirmed by the network",
+		Value: true,
+	}
+	noWaitRequest = &cli.BoolFlag{
+		Name:  noWaitFlag,
+		Usage: "return right after request is submitted",
+	}
+)
+
+// awaitProgress reports that awaited condition is checked once more.
+// It writes to stderr, so standard output stays machine-readable.
+func awaitProgress() {
+	fmt.Fprint(os.Stderr, "...")
+}
+
+// shouldWait checks --wait and --no-wait flags. Default value is
+// returned if none of them is set explicitly.
+func shouldWait(c *cli.Context, def bool) bool {
+	switch {
+	case c.Bool(noWaitFlag):
+		return false
+	case c.IsSet(waitFlag):
+		return c.Bool(waitFlag)
+	default:
+		return def
+	}
+}
+
+// await calls check with exponentially growing intervals until it reports
+// that condition is met, returns an error or timeout expires. In the last
+// case errNotConfirmed is returned. Progress is reported by the callers,
+// so await doesn't write anything itself.
+func await(ctx context.Context, timeout time.Duration, check func(context.Context) (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := awaitInitialInterval
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return errNotConfirmed
+		case <-timer.C:
+			if ok, err := check(ctx); err != nil {
+				return err
+			} else if ok {
+				return nil
+			}
+
+			if interval *= 2; interval > awaitMaxInterval {
+				interval = awaitMaxInterval
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
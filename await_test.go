@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func Test_shouldWait(t *testing.T) {
+	newContext := func(t *testing.T, args ...string) *cli.Context {
+		return newTestContext(t, []cli.Flag{waitRequest, noWaitRequest}, args...)
+	}
+
+	require.True(t, shouldWait(newContext(t), true))
+	require.False(t, shouldWait(newContext(t), false))
+	require.True(t, shouldWait(newContext(t, "--wait"), false))
+	require.False(t, shouldWait(newContext(t, "--wait=false"), true))
+	require.False(t, shouldWait(newContext(t, "--no-wait"), true))
+}
+
+func Test_await(t *testing.T) {
+	t.Run("condition met", func(t *testing.T) {
+		var calls int
+
+		err := await(context.Background(), time.Minute, func(context.Context) (bool, error) {
+			calls++
+			return calls == 2, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("check error", func(t *testing.T) {
+		errCheck := errors.New("check failed")
+
+		err := await(context.Background(), time.Minute, func(context.Context) (bool, error) {
+			return false, errCheck
+		})
+		require.Equal(t, errCheck, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		err := await(context.Background(), awaitInitialInterval/2, func(context.Context) (bool, error) {
+			t.Fatal("condition must not be checked")
+			return true, nil
+		})
+		require.Equal(t, errNotConfirmed, err)
+	})
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	publicContainerACLRule   = 0x1FFFFFFF
+	privateContainerACLRule  = 0x18888888
+	readonlyContainerACLRule = 0x1FFF88FF
+
+	basicACLStickyBit = 1 << 29
+	basicACLFinalBit  = 1 << 28
+
+	basicACLStickyToken = "sticky"
+	basicACLFinalToken  = "final"
+	basicACLAllToken    = "all"
+
+	basicACLBitsPerOp = 4
+	basicACLOpsMask   = 1<<28 - 1
+
+	// basicACLFirstOpBit is the most significant bit of the first operation section
+	basicACLFirstOpBit = 27
+)
+
+var (
+	// basicACLOperations are operations in the order of bit sections
+	// from the most significant bits.
+	basicACLOperations = []string{"get-range-hash", "get-range", "search", "delete", "put", "head", "get"}
+
+	// basicACLRoles are roles in the order of bits in operation section
+	// from the most significant bit.
+	basicACLRoles = []string{"user", "system", "others", "bearer"}
+)
+
+// basicACLBit returns the bit of basic ACL which allows operation to the role.
+func basicACLBit(op, role int) uint32 {
+	return 1 << uint(basicACLFirstOpBit-op*basicACLBitsPerOp-role)
+}
+
+func basicACLIndex(list []string, name string) int {
+	for i := range list {
+		if list[i] == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// parseBasicACL converts basic ACL keyword, symbolic expression
+// or 32-bit hex into numeric form.
+func parseBasicACL(s string) (uint32, error) {
+	switch s {
+	case "public":
+		return publicContainerACLRule, nil
+	case "private":
+		return privateContainerACLRule, nil
+	case "readonly":
+		return readonlyContainerACLRule, nil
+	}
+
+	if strings.ContainsAny(s, ":;") {
+		return parseSymbolicACL(s)
+	}
+
+	res, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 32)
+	if err != nil {
+		return 0, errors.Wrap(err, "incorrect basic ACL")
+	}
+
+	return uint32(res), nil
+}
+
+// parseSymbolicACL converts expression like "get,head,search:others;put:user;sticky"
+// into numeric basic ACL. Every rule allows listed operations to listed roles,
+// "all" can be used instead of the lists. Sticky and final bits are set by
+// the rules without roles.
+func parseSymbolicACL(s string) (uint32, error) {
+	var res uint32
+
+	for _, rule := range strings.Split(s, ";") {
+		rule = strings.TrimSpace(rule)
+
+		switch rule {
+		case "":
+			continue
+		case basicACLStickyToken:
+			res |= basicACLStickyBit
+			continue
+		case basicACLFinalToken:
+			res |= basicACLFinalBit
+			continue
+		}
+
+		items := strings.Split(rule, ":")
+		if len(items) != 2 {
+			return 0, errors.Errorf("basic ACL rule %q must have form 'operations:roles'", rule)
+		}
+
+		ops, err := parseBasicACLList(items[0], basicACLOperations)
+		if err != nil {
+			return 0, errors.Wrapf(err, "incorrect operations in basic ACL rule %q", rule)
+		}
+
+		roles, err := parseBasicACLList(items[1], basicACLRoles)
+		if err != nil {
+			return 0, errors.Wrapf(err, "incorrect roles in basic ACL rule %q", rule)
+		}
+
+		for _, op := range ops {
+			for _, role := range roles {
+				res |= basicACLBit(op, role)
+			}
+		}
+	}
+
+	if res&basicACLOpsMask == 0 {
+		return 0, errors.Errorf("basic ACL %q doesn't allow any operation", s)
+	}
+
+	return res, nil
+}
+
+// parseBasicACLList returns indices of comma separated names in the list.
+func parseBasicACLList(s string, list []string) ([]int, error) {
+	res := make([]int, 0, len(list))
+
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+
+		if name == basicACLAllToken {
+			for i := range list {
+				res = append(res, i)
+			}
+
+			continue
+		}
+
+		i := basicACLIndex(list, name)
+		if i < 0 {
+			return nil, errors.Errorf("unknown name %q, expected one of: %s, %s",
+				name, strings.Join(list, ", "), basicACLAllToken)
+		}
+
+		res = append(res, i)
+	}
+
+	return res, nil
+}
+
+func basicACLOutputFrom(acl uint32) basicACLOutput {
+	res := basicACLOutput{
+		Sticky:     acl&basicACLStickyBit != 0,
+		Final:      acl&basicACLFinalBit != 0,
+		Operations: make([]basicACLOperationOutput, 0, len(basicACLOperations)),
+	}
+
+	for op := range basicACLOperations {
+		item := basicACLOperationOutput{
+			Operation: basicACLOperations[op],
+			Roles:     make([]string, 0, len(basicACLRoles)),
+		}
+
+		for role := range basicACLRoles {
+			if acl&basicACLBit(op, role) != 0 {
+				item.Roles = append(item.Roles, basicACLRoles[role])
+			}
+		}
+
+		res.Operations = append(res.Operations, item)
+	}
+
+	return res
+}
+
+// displayBasicACL prints basic ACL as operation × role table
+// with allowed operations marked by '+'.
+func displayBasicACL(w io.Writer, acl basicACLOutput) error {
+	tw := tabwriter.NewWriter(w, 1, 8, 3, ' ', 0)
+
+	if _, err := fmt.Fprintf(tw, "OPERATION\t%s\n", strings.ToUpper(strings.Join(basicACLRoles, "\t"))); err != nil {
+		return err
+	}
+
+	for _, op := range acl.Operations {
+		marks := make([]string, 0, len(basicACLRoles))
+
+		for _, role := range basicACLRoles {
+			if basicACLIndex(op.Roles, role) >= 0 {
+				marks = append(marks, "+")
+			} else {
+				marks = append(marks, "-")
+			}
+		}
+
+		if _, err := fmt.Fprintf(tw, "%s\t%s\n", op.Operation, strings.Join(marks, "\t")); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(tw, "Sticky: %t, Final: %t\n", acl.Sticky, acl.Final); err != nil {
+		return err
+	}
+
+	return tw.Flush()
+}
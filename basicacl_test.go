@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseBasicACL(t *testing.T) {
+	for arg, res := range map[string]uint32{
+		"public":     publicContainerACLRule,
+		"private":    privateContainerACLRule,
+		"readonly":   readonlyContainerACLRule,
+		"0x1FFF88FF": readonlyContainerACLRule,
+		"18888888":   privateContainerACLRule,
+
+		"all:all;final":  publicContainerACLRule,
+		"all:user;final": privateContainerACLRule,
+		"get-range-hash,get-range,search,head,get:all; put,delete:user; final": readonlyContainerACLRule,
+
+		"get,head,search:others;put:user": 0x00020822,
+		"get:user,bearer;sticky":          0x20000009,
+	} {
+		acl, err := parseBasicACL(arg)
+		require.NoError(t, err, arg)
+		require.Equal(t, res, acl, arg)
+	}
+
+	for _, arg := range []string{"", "all", "0x1FFFFFFFF", "get:anyone", "read:user", "get", "get:user:others", "sticky;final", ";"} {
+		_, err := parseBasicACL(arg)
+		require.Error(t, err, arg)
+	}
+}
+
+func Test_basicACLOutputFrom(t *testing.T) {
+	out := basicACLOutputFrom(readonlyContainerACLRule)
+	require.False(t, out.Sticky)
+	require.True(t, out.Final)
+	require.Len(t, out.Operations, len(basicACLOperations))
+
+	for _, op := range out.Operations {
+		switch op.Operation {
+		case "put", "delete":
+			require.Equal(t, []string{"user"}, op.Roles)
+		default:
+			require.Equal(t, basicACLRoles, op.Roles)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, displayBasicACL(buf, basicACLOutputFrom(0x20000009)))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, len(basicACLOperations)+2)
+	require.Equal(t, []string{"get", "+", "-", "-", "+"}, strings.Fields(lines[len(lines)-2]))
+	require.Equal(t, "Sticky: true, Final: false", lines[len(lines)-1])
+}
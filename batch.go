@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-api-go/service"
+	"github.com/pkg/errors"
+)
+
+// tokenCache shares session tokens of the same verb between requests.
+//
+// Session tokens created by CLI are not bound to object address,
+// so one token can be used for all objects of a batch.
+type tokenCache struct {
+	mtx *sync.Mutex
+
+	tokens map[service.Token_Info_Verb]*service.Token
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{
+		mtx:    new(sync.Mutex),
+		tokens: make(map[service.Token_Info_Verb]*service.Token),
+	}
+}
+
+// get returns cached token of the verb or creates the new one.
+func (tc *tokenCache) get(p tokenParams) (*service.Token, error) {
+	tc.mtx.Lock()
+	defer tc.mtx.Unlock()
+
+	if token, ok := tc.tokens[p.verb]; ok {
+		return token, nil
+	}
+
+	token, err := newToken(p)
+	if err != nil {
+		return nil, err
+	}
+
+	tc.tokens[p.verb] = token
+
+	return token, nil
+}
+
+// runBatch calls task for each item in n parallel workers and returns
+// errors in the order of items. Failure of one item does not stop others.
+func runBatch(ctx context.Context, n int, items []string, task func(string) error) []error {
+	var (
+		wg   = new(sync.WaitGroup)
+		ch   = make(chan int)
+		errs = make([]error, len(items))
+	)
+
+	if n < 1 {
+		n = 1
+	}
+
+	for w := 0; w < n; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range ch {
+				errs[i] = task(items[i])
+			}
+		}()
+	}
+
+loop:
+	for i := range items {
+		select {
+		case <-ctx.Done():
+			for j := i; j < len(items); j++ {
+				errs[j] = ctx.Err()
+			}
+
+			break loop
+		case ch <- i:
+		}
+	}
+
+	close(ch)
+	wg.Wait()
+
+	return errs
+}
+
+// batchSummary prints per-item failures with the final counters
+// and returns an error if any of items failed. The error is caused by
+// errPayloadVerification if any of items failed verification.
+func batchSummary(w io.Writer, items []string, errs []error) error {
+	var failed, corrupted int
+
+	for i := range errs {
+		if errs[i] != nil {
+			failed++
+
+			if errors.Cause(errs[i]) == errPayloadVerification {
+				corrupted++
+			}
+		}
+	}
+
+	if len(items) > 1 {
+		if _, err := fmt.Fprintf(w, "\nSummary: %d succeeded, %d failed\n", len(items)-failed, failed); err != nil {
+			return err
+		}
+
+		for i := range errs {
+			if errs[i] == nil {
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "- %s: %s\n", items[i], errs[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch {
+	case failed == 0:
+		return nil
+	case len(items) == 1:
+		return errs[0]
+	case corrupted > 0:
+		return errors.Wrapf(errPayloadVerification, "%d of %d items failed, %d of them", failed, len(items), corrupted)
+	default:
+		return errors.Errorf("%d of %d items failed", failed, len(items))
+	}
+}
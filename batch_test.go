@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_runBatch(t *testing.T) {
+	var (
+		calls int32
+		items = []string{"a", "b", "c", "d", "e"}
+	)
+
+	errs := runBatch(context.Background(), 3, items, func(item string) error {
+		atomic.AddInt32(&calls, 1)
+
+		if item == "c" {
+			return errors.New("failed")
+		}
+
+		return nil
+	})
+
+	require.EqualValues(t, len(items), calls)
+	require.Len(t, errs, len(items))
+
+	for i := range items {
+		if items[i] == "c" {
+			require.EqualError(t, errs[i], "failed")
+		} else {
+			require.NoError(t, errs[i])
+		}
+	}
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		errs := runBatch(ctx, 1, items, func(string) error { return nil })
+		for i := range errs {
+			if errs[i] != nil {
+				require.Equal(t, context.Canceled, errs[i])
+			}
+		}
+	})
+}
+
+func Test_batchSummary(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	require.NoError(t, batchSummary(buf, []string{"a"}, []error{nil}))
+	require.Empty(t, buf.String())
+
+	require.EqualError(t, batchSummary(buf, []string{"a"}, []error{errors.New("failed")}), "failed")
+	require.Empty(t, buf.String())
+
+	err := batchSummary(buf, []string{"a", "b"}, []error{nil, errors.New("failed")})
+	require.EqualError(t, err, "1 of 2 items failed")
+	require.Equal(t, "\nSummary: 1 succeeded, 1 failed\n- b: failed\n", buf.String())
+}
+
+func Test_batchSummary_verification(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	err := batchSummary(buf, []string{"a", "b", "c"}, []error{
+		nil,
+		errors.New("failed"),
+		pkgerrors.Wrap(errPayloadVerification, "payload checksum mismatch"),
+	})
+	require.Equal(t, errPayloadVerification, pkgerrors.Cause(err))
+	require.EqualError(t, err, "2 of 3 items failed, 1 of them: payload verification failed")
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/nspcc-dev/neofs-api-go/service"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	expireFlag     = "expire"
+	bearerFileFlag = "bearer-file"
+)
+
+var (
+	bearerAction = &action{}
+
+	createBearerAction = &action{
+		Action: createBearer,
+		Flags: []cli.Flag{
+			eacl,
+			eaclRules,
+			&cli.Uint64Flag{
+				Name:     expireFlag,
+				Required: true,
+				Usage:    "last epoch when the token is valid",
+			},
+			&cli.StringFlag{
+				Name:     fileFlag,
+				Required: true,
+				Usage:    "path to output token file",
+			},
+			permissions,
+		},
+	}
+
+	inspectBearerAction = &action{
+		Action: inspectBearer,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     fileFlag,
+				Required: true,
+				Usage:    "path to token file",
+			},
+		},
+	}
+)
+
+// newBearerToken creates Bearer token with passed extended ACL rules
+// signed by the owner key.
+func newBearerToken(key *ecdsa.PrivateKey, rules []byte, expire uint64) (*service.BearerTokenMsg, error) {
+	owner, err := refs.NewOwnerID(&key.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute owner ID")
+	}
+
+	token := new(service.BearerTokenMsg)
+	token.SetExpirationEpoch(expire)
+	token.SetACLRules(rules)
+	token.SetOwnerID(owner)
+
+	if err := addSignatureWithKey(key, service.NewSignedBearerToken(token)); err != nil {
+		return nil, errors.Wrap(err, "could not sign Bearer token")
+	}
+
+	return token, nil
+}
+
+// readBearerToken reads Bearer token from the file and verifies its signature.
+func readBearerToken(path string) (*service.BearerTokenMsg, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read Bearer token file %s", path)
+	}
+
+	token := new(service.BearerTokenMsg)
+	if err := token.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal Bearer token")
+	}
+
+	if err := verifyBearerToken(token); err != nil {
+		return nil, errors.Wrap(err, "invalid Bearer token")
+	}
+
+	return token, nil
+}
+
+// verifyBearerToken checks that token is signed by the key of its owner.
+func verifyBearerToken(token *service.BearerTokenMsg) error {
+	key := crypto.UnmarshalPublicKey(token.GetOwnerKey())
+	if key == nil {
+		return errors.New("could not unmarshal owner key")
+	}
+
+	owner, err := refs.NewOwnerID(key)
+	if err != nil {
+		return errors.Wrap(err, "could not compute owner ID")
+	} else if !owner.Equal(token.GetOwnerID()) {
+		return errors.New("owner ID does not match owner key")
+	}
+
+	return service.VerifySignatureWithKey(key, service.NewVerifiedBearerToken(token))
+}
+
+func createBearer(c *cli.Context) error {
+	var (
+		err    error
+		rules  []byte
+		key    = getKey(c)
+		sEACL  = c.String(eaclFlag)
+		sRules = c.String(rulesFlag)
+		fPath  = c.String(fileFlag)
+		expire = c.Uint64(expireFlag)
+		perm   = c.Uint(permFlag)
+	)
+
+	if (sEACL == "") == (sRules == "") {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if sRules != "" {
+		data, err := ioutil.ReadFile(sRules)
+		if err != nil {
+			return errors.Wrapf(err, "could not read rules file %s", sRules)
+		}
+
+		if rules, err = compileEACL(data, eaclFormatFromPath(sRules)); err != nil {
+			return errors.Wrap(err, "could not compile extended ACL")
+		}
+	} else if rules, err = hex.DecodeString(sEACL); err != nil {
+		return errors.Wrap(err, "could not decode extended ACL")
+	}
+
+	token, err := newBearerToken(key, rules, expire)
+	if err != nil {
+		return err
+	}
+
+	data, err := token.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal Bearer token")
+	}
+
+	if err := ioutil.WriteFile(fPath, data, os.FileMode(perm)); err != nil {
+		return errors.Wrapf(err, "could not write Bearer token file %s", fPath)
+	}
+
+	out := bearerFileOutput{
+		OwnerID:         token.GetOwnerID().String(),
+		ExpirationEpoch: expire,
+		File:            fPath,
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Bearer token of %s valid until epoch %d saved to %s\n", out.OwnerID, out.ExpirationEpoch, out.File)
+		return err
+	})
+}
+
+func inspectBearer(c *cli.Context) error {
+	fPath := c.String(fileFlag)
+
+	data, err := ioutil.ReadFile(fPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read Bearer token file %s", fPath)
+	}
+
+	token := new(service.BearerTokenMsg)
+	if err := token.Unmarshal(data); err != nil {
+		return errors.Wrap(err, "could not unmarshal Bearer token")
+	}
+
+	out, err := bearerOutputFrom(token)
+	if err != nil {
+		return err
+	}
+
+	if err := printOutput(c, out, func(w io.Writer) error {
+		return displayBearer(w, out)
+	}); err != nil {
+		return err
+	} else if !out.Valid {
+		return errors.Errorf("invalid Bearer token: %s", out.Error)
+	}
+
+	return nil
+}
+
+func displayBearer(w io.Writer, out *bearerOutput) error {
+	rules, err := yaml.Marshal(out.Rules)
+	if err != nil {
+		return err
+	}
+
+	status := "valid"
+	if !out.Valid {
+		status = "invalid (" + out.Error + ")"
+	}
+
+	_, err = fmt.Fprintf(w, "Owner ID: %s\nOwner key: %s\nExpiration epoch: %d\nSignature: %s\nRules:\n%s",
+		out.OwnerID, out.OwnerKey, out.ExpirationEpoch, status, rules)
+
+	return err
+}
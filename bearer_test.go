@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/nspcc-dev/neofs-api-go/service"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// signBearerToken creates token signed by the test key.
+func signBearerToken(t *testing.T, i int, rules []byte) *service.BearerTokenMsg {
+	token, err := newBearerToken(test.DecodeKey(i), rules, 100)
+	require.NoError(t, err)
+
+	return token
+}
+
+func Test_BearerToken(t *testing.T) {
+	rules, err := compileEACL([]byte(`records:
+- operation: get
+  action: allow
+  targets:
+  - role: others
+`), eaclFormatYAML)
+	require.NoError(t, err)
+
+	token := signBearerToken(t, 0, rules)
+	require.NoError(t, verifyBearerToken(token))
+
+	dir, err := ioutil.TempDir("", "bearer")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	data, err := token.Marshal()
+	require.NoError(t, err)
+
+	fPath := filepath.Join(dir, "token")
+	require.NoError(t, ioutil.WriteFile(fPath, data, 0600))
+
+	res, err := readBearerToken(fPath)
+	require.NoError(t, err)
+	require.Equal(t, token.GetOwnerID(), res.GetOwnerID())
+	require.Equal(t, uint64(100), res.ExpirationEpoch())
+	require.Equal(t, rules, res.GetACLRules())
+
+	out, err := bearerOutputFrom(res)
+	require.NoError(t, err)
+	require.True(t, out.Valid)
+	require.Equal(t, uint64(100), out.ExpirationEpoch)
+	require.Len(t, out.Rules.Records, 1)
+
+	t.Run("modified token", func(t *testing.T) {
+		res.SetExpirationEpoch(200)
+		require.Error(t, verifyBearerToken(res))
+
+		out, err := bearerOutputFrom(res)
+		require.NoError(t, err)
+		require.False(t, out.Valid)
+		require.NotEmpty(t, out.Error)
+	})
+
+	t.Run("foreign owner key", func(t *testing.T) {
+		other := signBearerToken(t, 1, rules)
+		other.SetOwnerKey(token.GetOwnerKey())
+		require.Error(t, verifyBearerToken(other))
+	})
+
+	t.Run("corrupted file", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(fPath, []byte{1, 2, 3}, 0600))
+
+		_, err := readBearerToken(fPath)
+		require.Error(t, err)
+	})
+}
+
+func Test_verifyBearerToken(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	token := new(service.BearerTokenMsg)
+	require.Error(t, verifyBearerToken(token))
+
+	token.SetOwnerKey(crypto.MarshalPublicKey(&key.PublicKey))
+	require.EqualError(t, verifyBearerToken(token), "owner ID does not match owner key")
+
+	owner, err := refs.NewOwnerID(&key.PublicKey)
+	require.NoError(t, err)
+
+	token.SetOwnerID(owner)
+	token.SetSignature([]byte{1, 2, 3})
+	require.Error(t, verifyBearerToken(token))
+}
+
+func Test_createBearer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bearer")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	// key is loaded by the command
+	defer func() { loadedKey = nil }()
+
+	var (
+		key   = test.DecodeKey(0)
+		fPath = filepath.Join(dir, "token")
+		flags = append([]cli.Flag{keyFile, outputFormat}, createBearerAction.Flags...)
+	)
+
+	owner, err := refs.NewOwnerID(&key.PublicKey)
+	require.NoError(t, err)
+
+	rules, err := compileEACL([]byte("records:\n- operation: get\n  action: allow\n  targets:\n  - role: others\n"), eaclFormatYAML)
+	require.NoError(t, err)
+
+	c := newTestContext(t, flags,
+		"--key", hex.EncodeToString(crypto.MarshalPrivateKey(key)),
+		"--output", outputJSON,
+		"--eacl", hex.EncodeToString(rules),
+		"--expire", "100",
+		"--file", fPath,
+	)
+
+	res := captureStdout(t, func() {
+		require.NoError(t, createBearer(c))
+	})
+	require.JSONEq(t, `{"owner_id": "`+owner.String()+`", "expiration_epoch": 100, "file": "`+fPath+`"}`, res)
+
+	token, err := readBearerToken(fPath)
+	require.NoError(t, err)
+	require.NoError(t, verifyBearerToken(token))
+}
@@ -0,0 +1,443 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/accounting"
+	"github.com/nspcc-dev/neofs-api-go/chain"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+const (
+	irKeyFlag    = "ir-key"
+	quorumFlag   = "quorum"
+	contractFlag = "contract"
+
+	chequeResultValid   = "valid"
+	chequeResultInvalid = "invalid"
+
+	chequeFormatRaw  = "raw"
+	chequeFormatHex  = "hex"
+	chequeFormatJSON = "json"
+
+	// chequeOperation is a method of the main chain contract
+	// that accepts signed cheque.
+	chequeOperation = "cheque"
+
+	// chequeBodySize is a size of cheque ID, owner ID, amount and height.
+	chequeBodySize = chain.AddressLength + refs.OwnerIDSize + 8 + 8
+
+	chequeSignatureSize = crypto.PublicKeyCompressedSize + crypto.RFC6979SignatureSize
+)
+
+var (
+	verifyWithdrawAction = &action{
+		Action: verifyWithdraw,
+		Flags: []cli.Flag{
+			withdrawID,
+			&cli.StringFlag{
+				Name:  fileFlag,
+				Usage: "path to cheque file in raw or hex format",
+			},
+			&cli.StringSliceFlag{
+				Name:  irKeyFlag,
+				Usage: "public key of inner ring node in hex or base58 format",
+			},
+			&cli.UintFlag{
+				Name:  quorumFlag,
+				Usage: "number of inner ring signatures required, 2/3 of inner ring keys + 1 by default",
+			},
+		},
+	}
+
+	exportWithdrawAction = &action{
+		Action: exportWithdraw,
+		Flags: []cli.Flag{
+			withdrawID,
+			&cli.StringFlag{
+				Name:  fileFlag,
+				Usage: "path to output file",
+			},
+			&cli.StringFlag{
+				Name:  formatFlag,
+				Usage: "output format: raw, hex or json, hex for standard output and raw for file by default",
+			},
+			&cli.StringFlag{
+				Name:  contractFlag,
+				Usage: "script hash of the main chain contract, required for json format",
+			},
+		},
+	}
+)
+
+// errChequeVerification is a cause of errors of cheques that have
+// invalid signatures or aren't signed by inner ring quorum.
+var errChequeVerification = errors.New("cheque verification failed")
+
+// chequeSignature is a public key and signature pair of the cheque
+// that isn't verified yet.
+type chequeSignature struct {
+	key []byte
+	sig []byte
+}
+
+// fetchCheque receives binary cheque of the withdrawal from the network.
+func fetchCheque(ctx context.Context, c *cli.Context, conn *grpc.ClientConn, wid string) ([]byte, error) {
+	owner, err := refs.NewOwnerID(&getKey(c).PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute owner ID")
+	}
+
+	req := &accounting.GetRequest{
+		ID:      accounting.ChequeID(wid),
+		OwnerID: owner,
+	}
+	setTTL(c, req)
+	setRaw(c, req)
+	signRequest(c, req)
+
+	resp, err := accounting.NewWithdrawClient(conn).Get(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't perform request")
+	}
+
+	return resp.Withdraw.Payload, nil
+}
+
+// readCheque reads cheque from the file or receives it from the network.
+// Cheque file can contain raw bytes or hex string.
+func readCheque(c *cli.Context) ([]byte, error) {
+	var (
+		wid   = c.String(widFlag)
+		fPath = c.String(fileFlag)
+	)
+
+	switch {
+	case wid != "" && fPath != "":
+		return nil, errors.Errorf("--%s and --%s can't be used together", widFlag, fileFlag)
+	case fPath != "":
+		data, err := ioutil.ReadFile(fPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read cheque file %s", fPath)
+		}
+
+		if dec, err := hex.DecodeString(string(bytes.TrimSpace(data))); err == nil {
+			return dec, nil
+		}
+
+		return data, nil
+	case wid != "":
+		var (
+			host = getHost(c)
+			ctx  = gracefulContext()
+		)
+
+		conn, err := connect(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't connect to host '%s'", host)
+		}
+
+		return fetchCheque(ctx, c, conn, wid)
+	default:
+		return nil, errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+}
+
+// splitCheque parses cheque body and signatures without verification,
+// so every signature can be checked separately.
+func splitCheque(data []byte) (*accounting.Cheque, []chequeSignature, error) {
+	if len(data) < chequeBodySize+2 {
+		return nil, nil, accounting.ErrWrongChequeData
+	}
+
+	var (
+		body  = data[:chequeBodySize]
+		count = int(binary.LittleEndian.Uint16(data[chequeBodySize:]))
+		sigs  = data[chequeBodySize+2:]
+		ch    = new(accounting.Cheque)
+	)
+
+	if len(sigs) != count*chequeSignatureSize {
+		return nil, nil, errors.Wrapf(accounting.ErrWrongChequeData, "expected %d signatures", count)
+	}
+
+	// body followed by zero signature count is a valid unsigned cheque
+	if err := ch.UnmarshalBinary(append(body[:chequeBodySize:chequeBodySize], 0, 0)); err != nil {
+		return nil, nil, err
+	}
+
+	res := make([]chequeSignature, 0, count)
+
+	for i := 0; i < count; i++ {
+		item := sigs[i*chequeSignatureSize : (i+1)*chequeSignatureSize]
+
+		res = append(res, chequeSignature{
+			key: item[:crypto.PublicKeyCompressedSize],
+			sig: item[crypto.PublicKeyCompressedSize:],
+		})
+	}
+
+	return ch, res, nil
+}
+
+// parsePublicKeys parses compressed public keys in hex or base58 format.
+func parsePublicKeys(keys []string) ([][]byte, error) {
+	res := make([][]byte, 0, len(keys))
+
+	for _, s := range keys {
+		key, err := hex.DecodeString(s)
+		if err != nil {
+			if key, err = base58.Decode(s); err != nil {
+				return nil, errors.Errorf("public key %s must be in hex or base58 format", s)
+			}
+		}
+
+		if crypto.UnmarshalPublicKey(key) == nil {
+			return nil, errors.Errorf("invalid public key %s", s)
+		}
+
+		res = append(res, key)
+	}
+
+	return res, nil
+}
+
+// verifyCheque checks every signature of the cheque and counts valid
+// signatures of inner ring nodes. Unsigned cheque never passes, quorum
+// is checked only if inner ring keys are set.
+func verifyCheque(data []byte, irKeys [][]byte, quorum uint) (*chequeVerifyOutput, error) {
+	ch, sigs, err := splitCheque(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse cheque")
+	}
+
+	var (
+		body   = data[:chequeBodySize]
+		signed = make(map[string]struct{}, len(irKeys))
+		out    = &chequeVerifyOutput{
+			ID:         ch.ID.String(),
+			OwnerID:    ch.Owner.String(),
+			Amount:     ch.Amount.String(),
+			Height:     ch.Height,
+			Signatures: make([]chequeSignatureOutput, 0, len(sigs)),
+			Quorum:     quorum,
+			Passed:     true,
+		}
+	)
+
+	for _, s := range sigs {
+		item := chequeSignatureOutput{
+			Key:    base58.Encode(s.key),
+			Result: chequeResultValid,
+		}
+
+		for i := range irKeys {
+			if bytes.Equal(irKeys[i], s.key) {
+				item.InnerRing = true
+				break
+			}
+		}
+
+		if key := crypto.UnmarshalPublicKey(s.key); key == nil {
+			item.Result = "invalid public key"
+		} else if err := crypto.VerifyRFC6979(key, body, s.sig); err != nil {
+			item.Result = "invalid signature"
+		} else if item.InnerRing {
+			signed[string(s.key)] = struct{}{}
+		}
+
+		out.Passed = out.Passed && item.Result == chequeResultValid
+		out.Signatures = append(out.Signatures, item)
+	}
+
+	out.Confirmed = uint(len(signed))
+
+	if len(sigs) == 0 || len(irKeys) > 0 && out.Confirmed < quorum {
+		out.Passed = false
+	}
+
+	return out, nil
+}
+
+func verifyWithdraw(c *cli.Context) error {
+	irKeys, err := parsePublicKeys(c.StringSlice(irKeyFlag))
+	if err != nil {
+		return err
+	}
+
+	quorum := c.Uint(quorumFlag)
+
+	switch {
+	case c.IsSet(quorumFlag) && len(irKeys) == 0:
+		return errors.Errorf("--%s requires inner ring keys (--%s)", quorumFlag, irKeyFlag)
+	case quorum > uint(len(irKeys)):
+		return errors.Errorf("quorum %d is greater than number of inner ring keys %d", quorum, len(irKeys))
+	case quorum == 0 && len(irKeys) > 0:
+		quorum = uint(len(irKeys))*2/3 + 1
+	}
+
+	data, err := readCheque(c)
+	if err != nil {
+		return err
+	}
+
+	out, err := verifyCheque(data, irKeys, quorum)
+	if err != nil {
+		return err
+	}
+
+	if err := printOutput(c, out, func(w io.Writer) error {
+		return displayChequeVerify(w, out, len(irKeys) > 0)
+	}); err != nil {
+		return err
+	} else if !out.Passed {
+		return errors.Wrapf(errChequeVerification, "cheque %s", out.ID)
+	}
+
+	return nil
+}
+
+func displayChequeVerify(w io.Writer, out *chequeVerifyOutput, withIR bool) error {
+	tw := tabwriter.NewWriter(w, 1, 8, 3, ' ', 0)
+
+	if _, err := fmt.Fprintf(tw, "Withdraw ID: %s\nOwner ID: %s\nAmount: %s\nHeight: %d\n",
+		out.ID, out.OwnerID, out.Amount, out.Height); err != nil {
+		return err
+	} else if _, err := fmt.Fprintln(tw, "KEY\tINNER RING\tRESULT"); err != nil {
+		return err
+	}
+
+	for _, s := range out.Signatures {
+		if _, err := fmt.Fprintf(tw, "%s\t%t\t%s\n", s.Key, s.InnerRing, s.Result); err != nil {
+			return err
+		}
+	}
+
+	if withIR {
+		if _, err := fmt.Fprintf(tw, "Inner ring signatures: %d (quorum %d)\n", out.Confirmed, out.Quorum); err != nil {
+			return err
+		}
+	}
+
+	result := chequeResultValid
+	if !out.Passed {
+		result = chequeResultInvalid
+	}
+
+	if _, err := fmt.Fprintf(tw, "Result: %s\n", result); err != nil {
+		return err
+	}
+
+	return tw.Flush()
+}
+
+// chequeContractCall returns JSON-RPC invokefunction request that passes
+// signed cheque to the main chain contract.
+func chequeContractCall(contract string, data []byte) ([]byte, error) {
+	hash, err := hex.DecodeString(strings.TrimPrefix(contract, "0x"))
+	if err != nil || len(hash) != 20 {
+		return nil, errors.Errorf("contract script hash %s must be 20 bytes in hex format", contract)
+	}
+
+	return json.MarshalIndent(contractCallOutput{
+		JSONRPC: "2.0",
+		Method:  "invokefunction",
+		Params: []interface{}{
+			hex.EncodeToString(hash),
+			chequeOperation,
+			[]contractParamOutput{{Type: "ByteArray", Value: hex.EncodeToString(data)}},
+		},
+		ID: 1,
+	}, "", "  ")
+}
+
+func exportWithdraw(c *cli.Context) error {
+	var (
+		err  error
+		data []byte
+		conn *grpc.ClientConn
+
+		host   = getHost(c)
+		ctx    = gracefulContext()
+		wid    = c.String(widFlag)
+		fPath  = c.String(fileFlag)
+		format = c.String(formatFlag)
+	)
+
+	if wid == "" {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if format == "" {
+		if format = chequeFormatHex; fPath != "" {
+			format = chequeFormatRaw
+		}
+	}
+
+	switch format {
+	case chequeFormatRaw:
+		if fPath == "" {
+			return errors.New("raw cheque can be written only to file")
+		}
+	case chequeFormatHex:
+	case chequeFormatJSON:
+		if c.String(contractFlag) == "" {
+			return errors.Errorf("--%s is required for %s format", contractFlag, chequeFormatJSON)
+		}
+	default:
+		return errors.Errorf("unknown cheque format: %q", format)
+	}
+
+	if conn, err = connect(ctx, c); err != nil {
+		return errors.Wrapf(err, "can't connect to host '%s'", host)
+	}
+
+	cheque, err := fetchCheque(ctx, c, conn, wid)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case chequeFormatRaw:
+		data = cheque
+	case chequeFormatHex:
+		data = []byte(hex.EncodeToString(cheque) + "\n")
+	case chequeFormatJSON:
+		if data, err = chequeContractCall(c.String(contractFlag), cheque); err != nil {
+			return err
+		}
+
+		data = append(data, '\n')
+	}
+
+	if fPath == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	if err = ioutil.WriteFile(fPath, data, defaultPermission); err != nil {
+		return errors.Wrapf(err, "could not write cheque file %s", fPath)
+	}
+
+	out := chequeFileOutput{ID: wid, Format: format, File: fPath}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Cheque of withdrawal %s saved to %s\n", out.ID, out.File)
+		return err
+	})
+}
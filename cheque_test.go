@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/accounting"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_splitCheque(t *testing.T) {
+	data := mockedCheque(t)
+
+	ch, sigs, err := splitCheque(data)
+	require.NoError(t, err)
+	require.Equal(t, "1111111111111111111111111", ch.ID.String())
+	require.Equal(t, uint64(100), ch.Height)
+	require.Len(t, sigs, 4)
+	require.Equal(t, crypto.MarshalPublicKey(&test.DecodeKey(1).PublicKey), sigs[1].key)
+
+	_, _, err = splitCheque(data[:len(data)-1])
+	require.Equal(t, accounting.ErrWrongChequeData, errors.Cause(err))
+
+	_, _, err = splitCheque(nil)
+	require.Equal(t, accounting.ErrWrongChequeData, errors.Cause(err))
+}
+
+func Test_verifyCheque(t *testing.T) {
+	irKeys := [][]byte{
+		crypto.MarshalPublicKey(&test.DecodeKey(1).PublicKey),
+		crypto.MarshalPublicKey(&test.DecodeKey(2).PublicKey),
+		crypto.MarshalPublicKey(&test.DecodeKey(3).PublicKey),
+		crypto.MarshalPublicKey(&test.DecodeKey(4).PublicKey),
+	}
+
+	t.Run("signatures only", func(t *testing.T) {
+		out, err := verifyCheque(mockedCheque(t), nil, 0)
+		require.NoError(t, err)
+		require.True(t, out.Passed)
+		require.Len(t, out.Signatures, 4)
+		require.Zero(t, out.Confirmed)
+	})
+
+	t.Run("quorum", func(t *testing.T) {
+		out, err := verifyCheque(mockedCheque(t), irKeys, 3)
+		require.NoError(t, err)
+		require.True(t, out.Passed)
+		require.Equal(t, uint(3), out.Confirmed)
+		require.False(t, out.Signatures[0].InnerRing)
+		require.True(t, out.Signatures[1].InnerRing)
+
+		out, err = verifyCheque(mockedCheque(t), irKeys, 4)
+		require.NoError(t, err)
+		require.False(t, out.Passed)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		data := append(mockedCheque(t)[:chequeBodySize], 0, 0)
+
+		out, err := verifyCheque(data, nil, 0)
+		require.NoError(t, err)
+		require.False(t, out.Passed)
+		require.Empty(t, out.Signatures)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		data := mockedCheque(t)
+		data[len(data)-1]++
+
+		out, err := verifyCheque(data, irKeys, 2)
+		require.NoError(t, err)
+		require.False(t, out.Passed)
+		require.Equal(t, uint(2), out.Confirmed)
+		require.Equal(t, chequeResultValid, out.Signatures[2].Result)
+		require.NotEqual(t, chequeResultValid, out.Signatures[3].Result)
+	})
+}
+
+func Test_parsePublicKeys(t *testing.T) {
+	key := crypto.MarshalPublicKey(&test.DecodeKey(1).PublicKey)
+
+	keys, err := parsePublicKeys([]string{hex.EncodeToString(key), base58.Encode(key)})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{key, key}, keys)
+
+	_, err = parsePublicKeys([]string{"0102"})
+	require.Error(t, err)
+
+	_, err = parsePublicKeys([]string{"not a key"})
+	require.Error(t, err)
+}
+
+func Test_chequeContractCall(t *testing.T) {
+	var (
+		cheque   = []byte{1, 2, 3}
+		contract = "0x5f490b0f5ff9ea4a1d3b1c7bb5d5ddc1a3d3e2f1"
+	)
+
+	data, err := chequeContractCall(contract, cheque)
+	require.NoError(t, err)
+
+	var call struct {
+		Method string        `json:"method"`
+		Params []interface{} `json:"params"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &call))
+	require.Equal(t, "invokefunction", call.Method)
+	require.Equal(t, []interface{}{
+		contract[2:],
+		chequeOperation,
+		[]interface{}{map[string]interface{}{"type": "ByteArray", "value": "010203"}},
+	}, call.Params)
+
+	_, err = chequeContractCall("0102", cheque)
+	require.Error(t, err)
+}
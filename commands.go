@@ -6,7 +6,7 @@ func commands() cli.Commands {
 	return cli.Commands{
 		{
 			Name:      "set",
-			Usage:     "set default values for key or host",
+			Usage:     "set default values for key or host of the selected profile",
 			UsageText: "set <mode:key|host> <value>",
 			Subcommands: cli.Commands{
 				{
@@ -14,6 +14,7 @@ func commands() cli.Commands {
 					Usage:       "set default value for key",
 					UsageText:   "set key <value>",
 					Description: "set user default value for key",
+					Flags:       []cli.Flag{plainKey},
 					Action:      setCommand(KeyMode),
 				},
 				{
@@ -25,6 +26,86 @@ func commands() cli.Commands {
 				},
 			},
 		},
+		{
+			Name:      "profile",
+			Usage:     "manage named profiles from config",
+			UsageText: "profile <subcommand> [arguments...]",
+			Flags:     getFlags(Profile),
+			Subcommands: cli.Commands{
+				{
+					Name:        "list",
+					Usage:       "list profiles",
+					UsageText:   "list",
+					Description: "list profiles stored in config, current one is marked with *",
+					Flags:       getFlags(ListProfiles),
+					Action:      getAction(ListProfiles),
+				},
+				{
+					Name:        "use",
+					Usage:       "set current profile",
+					UsageText:   "use <name>",
+					Description: "set profile used by default",
+					Flags:       getFlags(UseProfile),
+					Action:      getAction(UseProfile),
+				},
+				{
+					Name:        "show",
+					Usage:       "show profile settings",
+					UsageText:   "show [<name>]",
+					Description: "show settings of the named or current profile",
+					Flags:       getFlags(ShowProfile),
+					Action:      getAction(ShowProfile),
+				},
+				{
+					Name:        "delete",
+					Usage:       "delete profile",
+					UsageText:   "delete <name>",
+					Description: "delete profile from config",
+					Flags:       getFlags(DeleteProfile),
+					Action:      getAction(DeleteProfile),
+				},
+			},
+		},
+		{
+			Name:      "key",
+			Usage:     "private key management",
+			UsageText: "key <subcommand> [arguments...]",
+			Flags:     getFlags(Key),
+			Subcommands: cli.Commands{
+				{
+					Name:        "generate",
+					Usage:       "generate new private key",
+					UsageText:   "generate [--format <hex|wif|raw|nep2>] [--file </path/to/key>]",
+					Description: "generate new private key and print it or save to the file",
+					Flags:       getFlags(GenerateKey),
+					Action:      getAction(GenerateKey),
+				},
+				{
+					Name:        "info",
+					Usage:       "show key identity",
+					UsageText:   "neofs-cli --key <key:path|hex|wif|nep2> key info",
+					Description: "show public key, address and owner ID of the private key",
+					Flags:       getFlags(InfoKey),
+					Action:      getAction(InfoKey),
+				},
+				{
+					Name:        "convert",
+					Usage:       "convert key format",
+					UsageText:   "neofs-cli --key <key:path|hex|wif|nep2> key convert --format <hex|wif|raw|nep2> [--file </path/to/key>]",
+					Description: "convert private key into another format",
+					Flags:       getFlags(ConvertKey),
+					Action:      getAction(ConvertKey),
+				},
+			},
+		},
+		{
+			Name:        "hash",
+			Usage:       "calculate homomorphic hash of local file",
+			UsageText:   "hash --file </path/to/file> [--salt <hex>] [--concat] [offset1:length1 [offset2:length2]...]",
+			Description: "calculate homomorphic hash of the file or its ranges without connection to the network",
+			Flags:       getFlags(Hash),
+			Action:      getAction(Hash),
+		},
 		{
 			Name:      "object",
 			Usage:     "object manipulation",
@@ -34,8 +115,8 @@ func commands() cli.Commands {
 				{
 					Name:  "put",
 					Usage: "put object into container",
-					UsageText: "put --cid <cid> --file </path/to/file> " +
-						"[--perm <permissions>] [--verify] [--copies <number>] [--user key1=value1 ...] [--bearer <hex>]",
+					UsageText: "put --cid <cid> (--file </path/to/file> | --dir </path/to/dir> [--include <glob> ...] [--exclude <glob> ...]) " +
+						"[--perm <permissions>] [--verify] [--copies <number>] [--user key1=value1 ...] [--max-object-size <MB>] [--concurrency <number>] [--bearer <hex> | --bearer-file <path>]",
 					Description: "put user data into container",
 					Flags:       getFlags(PutObject),
 					Action:      getAction(PutObject),
@@ -43,7 +124,7 @@ func commands() cli.Commands {
 				{
 					Name:        "get",
 					Usage:       "get object from container",
-					UsageText:   "get --cid <cid> --oid <oid> --file ./my-file [--perm <permissions>] [--bearer <hex>]",
+					UsageText:   "get --cid <cid> (--oid <oid> --file ./my-file | --oid <oid1> --oid <oid2> ... --dir ./my-dir [--concurrency <number>]) [--resume] [--no-verify] [--perm <permissions>] [--bearer <hex> | --bearer-file <path>]",
 					Description: "get file from network",
 					Flags:       getFlags(GetObject),
 					Action:      getAction(GetObject),
@@ -51,7 +132,7 @@ func commands() cli.Commands {
 				{
 					Name:        "delete",
 					Usage:       "delete object from container",
-					UsageText:   "delete --cid <cid> --oid <oid> [--bearer <hex>]",
+					UsageText:   "delete --cid <cid> --oid <oid> [--bearer <hex> | --bearer-file <path>]",
 					Description: "delete file from network",
 					Flags:       getFlags(DelObject),
 					Action:      getAction(DelObject),
@@ -59,7 +140,7 @@ func commands() cli.Commands {
 				{
 					Name:        "head",
 					Usage:       "get object header from container",
-					UsageText:   "head --cid <cid> --oid <oid> [--full-headers] [--bearer <hex>]",
+					UsageText:   "head --cid <cid> --oid <oid> [--full-headers] [--bearer <hex> | --bearer-file <path>]",
 					Description: "retrieve object metadata",
 					Flags:       getFlags(HeadObject),
 					Action:      getAction(HeadObject),
@@ -67,22 +148,23 @@ func commands() cli.Commands {
 				{
 					Name:        "search",
 					Usage:       "perform search query within container",
-					UsageText:   "search --cid <cid> [--bearer <hex>] [<key1> <query1> [<key2> <query2>...]]",
+					UsageText:   "search --cid <cid> [--bearer <hex> | --bearer-file <path>] [--query <expression>] [<key1> <query1> [<key2> <query2>...]]",
 					Description: "search object by headers",
 					Flags:       getFlags(SearchObject),
 					Action:      getAction(SearchObject),
 				},
 				{
-					Name:      "get-range",
-					Usage:     "get data of the object payload ranges from container",
-					UsageText: "get-range --cid <cid> --oid <oid> [--bearer <hex>] <offset>:<length>",
-					Flags:     getFlags(GetRangeObject),
-					Action:    getAction(GetRangeObject),
+					Name:  "get-range",
+					Usage: "get data of the object payload ranges from container",
+					UsageText: "get-range --cid <cid> --oid <oid> [--file </path/to/file>] [--format <raw|hex|base64>] [--perm <permissions>] [--bearer <hex> | --bearer-file <path>] [--] " +
+						"<offset>:<length>|<offset>:|-<length> [...]",
+					Flags:  getFlags(GetRangeObject),
+					Action: getAction(GetRangeObject),
 				},
 				{
 					Name:      "get-range-hash",
 					Usage:     "get homomorphic hash of the object payload ranges from container",
-					UsageText: "get-range-hash --cid <cid> --oid <oid> [--bearer <hex>] [--verify --file </path/to/file>] [--salt <hex>] [<offset1>:<length1> [...]]",
+					UsageText: "get-range-hash --cid <cid> --oid <oid> [--bearer <hex> | --bearer-file <path>] [--verify --file </path/to/file>] [--salt <hex>] [<offset1>:<length1> [...]]",
 					Flags:     getFlags(GetRangeHashObject),
 					Action:    getAction(GetRangeHashObject),
 				},
@@ -97,8 +179,8 @@ func commands() cli.Commands {
 				{
 					Name:        "put",
 					Usage:       "put storage group in system",
-					UsageText:   "put --cid <cid> --sgid <uuid>...",
-					Description: "put new storage group",
+					UsageText:   "put --cid <cid> (--oid <oid> [--oid <oid>...] | [--root] [--query <expr>] [<key> <value>...]) [--lifetime <unit:value>] [--yes]",
+					Description: "put new storage group of listed objects or objects matching search filters",
 					Flags:       getFlags(PutStorageGroup),
 					Action:      getAction(PutStorageGroup),
 				},
@@ -126,6 +208,14 @@ func commands() cli.Commands {
 					Flags:       getFlags(DeleteStorageGroup),
 					Action:      getAction(DeleteStorageGroup),
 				},
+				{
+					Name:        "verify",
+					Usage:       "verify storage group data",
+					UsageText:   "verify --cid <cid> --sgid <sgid>",
+					Description: "check that payload of storage group members matches its validation data",
+					Flags:       getFlags(VerifyStorageGroup),
+					Action:      getAction(VerifyStorageGroup),
+				},
 			},
 		},
 		{
@@ -137,11 +227,27 @@ func commands() cli.Commands {
 				{
 					Name:        "put",
 					Usage:       "put container",
-					UsageText:   "put --rule 'SELECT 3 Node FILTER State NE IR' [--cap <cap-in-GB>]",
+					UsageText:   "put (--rule 'SELECT 3 Node FILTER State NE IR' [--cap <cap-in-GB>] [--acl <acl>] | --spec </path/to/container.yml>) [--no-wait] [--timeout <duration>]",
 					Description: "put container into network",
 					Flags:       getFlags(PutContainer),
 					Action:      getAction(PutContainer),
 				},
+				{
+					Name:        "apply",
+					Usage:       "compare container with spec",
+					UsageText:   "apply --cid <cid> --spec </path/to/container.yml> [--dry-run]",
+					Description: "report difference between container spec and container in network, update extended ACL if it differs",
+					Flags:       getFlags(ApplyContainer),
+					Action:      getAction(ApplyContainer),
+				},
+				{
+					Name:        "simulate",
+					Usage:       "simulate container placement",
+					UsageText:   "simulate --rule 'SELECT 3 Node FILTER State NE IR' --netmap </path/to/netmap.json> [--cid <cid> [--oid <oid>]]",
+					Description: "select nodes for container and object from saved network map without connection to the network",
+					Flags:       getFlags(SimulateContainer),
+					Action:      getAction(SimulateContainer),
+				},
 				{
 					Name:        "get",
 					Usage:       "get container",
@@ -153,7 +259,7 @@ func commands() cli.Commands {
 				{
 					Name:        "delete",
 					Usage:       "delete container",
-					UsageText:   "delete --cid <cid>",
+					UsageText:   "delete --cid <cid> [--no-wait] [--timeout <duration>]",
 					Description: "delete container from network",
 					Flags:       getFlags(DelContainer),
 					Action:      getAction(DelContainer),
@@ -169,7 +275,7 @@ func commands() cli.Commands {
 				{
 					Name:        "set-eacl",
 					Usage:       "set extended ACL rules",
-					UsageText:   "set-eacl --cid <cid> --eacl <hex>",
+					UsageText:   "set-eacl --cid <cid> (--eacl <hex> | --rules </path/to/rules.yml>)",
 					Description: "change extended ACL rules of user container",
 					Flags:       getFlags(SetContainerEACL),
 					Action:      getAction(SetContainerEACL),
@@ -177,13 +283,37 @@ func commands() cli.Commands {
 				{
 					Name:        "get-eacl",
 					Usage:       "get extended ACL rules",
-					UsageText:   "get-eacl --cid <cid>",
+					UsageText:   "get-eacl --cid <cid> [--format <hex|rules>]",
 					Description: "receive extended ACL rules of user container",
 					Flags:       getFlags(GetContainerEACL),
 					Action:      getAction(GetContainerEACL),
 				},
 			},
 		},
+		{
+			Name:      "bearer",
+			Usage:     "bearer token manipulation",
+			UsageText: "bearer <subcommand> [arguments...]",
+			Flags:     getFlags(Bearer),
+			Subcommands: cli.Commands{
+				{
+					Name:        "create",
+					Usage:       "create signed bearer token",
+					UsageText:   "create (--eacl <hex> | --rules </path/to/rules.yml>) --expire <epoch> --file </path/to/token> [--perm <permissions>]",
+					Description: "create bearer token with extended ACL rules signed by user key",
+					Flags:       getFlags(CreateBearer),
+					Action:      getAction(CreateBearer),
+				},
+				{
+					Name:        "inspect",
+					Usage:       "inspect bearer token",
+					UsageText:   "inspect --file </path/to/token>",
+					Description: "decode bearer token and verify its signature",
+					Flags:       getFlags(InspectBearer),
+					Action:      getAction(InspectBearer),
+				},
+			},
+		},
 		{
 			Name:      "withdraw",
 			Usage:     "withdrawals manipulation",
@@ -222,6 +352,22 @@ func commands() cli.Commands {
 					Flags:       getFlags(ListWithdraw),
 					Action:      getAction(ListWithdraw),
 				},
+				{
+					Name:        "verify",
+					Usage:       "verify withdrawal cheque",
+					UsageText:   "verify (--wid <wid> | --file <path>) [--ir-key <key>...] [--quorum <number>]",
+					Description: "check signatures of withdrawal cheque and quorum of inner ring signatures",
+					Flags:       getFlags(VerifyWithdraw),
+					Action:      getAction(VerifyWithdraw),
+				},
+				{
+					Name:        "export",
+					Usage:       "export withdrawal cheque",
+					UsageText:   "export --wid <wid> [--file <path>] [--format raw|hex|json] [--contract <script hash>]",
+					Description: "save signed cheque or contract call with it for redemption on the main chain",
+					Flags:       getFlags(ExportWithdraw),
+					Action:      getAction(ExportWithdraw),
+				},
 			},
 		},
 		{
@@ -281,7 +427,7 @@ func commands() cli.Commands {
 				{
 					Name:        "config",
 					Usage:       "dump config of specified node",
-					UsageText:   "neofs-cli --host <host:port> --key <key:path|hex|wif> status config",
+					UsageText:   "neofs-cli --host <host:port> --key <key:path|hex|wif|nep2> status config",
 					Description: "allows dumping runtime config of specified node",
 					Flags:       getFlags(GetConfig),
 					Action:      getAction(GetConfig),
@@ -289,7 +435,7 @@ func commands() cli.Commands {
 				{
 					Name:        "dump_vars",
 					Usage:       "dump debug variables of specified node",
-					UsageText:   "neofs-cli --host <host:port> --key <key:path|hex|wif> status dump_vars",
+					UsageText:   "neofs-cli --host <host:port> --key <key:path|hex|wif|nep2> status dump_vars",
 					Description: "allows dumping debug variables of specified node",
 					Flags:       getFlags(GetDebugVars),
 					Action:      getAction(GetDebugVars),
@@ -297,7 +443,7 @@ func commands() cli.Commands {
 				{
 					Name:        "change_state",
 					Usage:       "change state of specified node",
-					UsageText:   "neofs-cli --host <host:port> --key <key:path|hex|wif> status change_state",
+					UsageText:   "neofs-cli --host <host:port> --key <key:path|hex|wif|nep2> status change_state",
 					Description: "allows change state of specified node",
 					Flags:       getFlags(ChangeState),
 					Action:      getAction(ChangeState),
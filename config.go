@@ -2,18 +2,22 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
-	crypto "github.com/nspcc-dev/neofs-crypto"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 )
 
@@ -30,8 +34,37 @@ const (
 	HostEnvValue   = "NEOFS_CLI_ADDRESS"
 	HostCfgValue   = "host"
 	ConfigEnvValue = "NEOFS_CLI_CONFIG"
+
+	ProfileEnvValue     = "NEOFS_CLI_PROFILE"
+	ProfileCfgValue     = "profile"
+	ProfilesCfgValue    = "profiles"
+	TTLCfgValue         = "ttl"
+	XHeadersCfgValue    = "xhdr"
+	DialTimeoutCfgValue = "dial-timeout"
+	TimeoutCfgValue     = "timeout"
+	AddressCfgValue     = "address"
+
+	TLSCfgValue                = "tls"
+	CACertCfgValue             = "ca-cert"
+	ClientCertCfgValue         = "client-cert"
+	ClientKeyCfgValue          = "client-key"
+	InsecureSkipVerifyCfgValue = "insecure-skip-verify"
+
+	dialTimeoutFlag        = "dial-timeout"
+	tlsFlag                = "tls"
+	caCertFlag             = "ca-cert"
+	clientCertFlag         = "client-cert"
+	clientKeyFlag          = "client-key"
+	insecureSkipVerifyFlag = "insecure-skip-verify"
+
+	grpcScheme  = "grpc"
+	grpcsScheme = "grpcs"
 )
 
+// activeProfile contains settings of the profile selected
+// by --profile flag or by `profile use` command.
+var activeProfile *viper.Viper
+
 func beforeAction(c *cli.Context) error {
 	if args := c.Args(); args.Len() == 0 { // ignore help command
 		return nil
@@ -49,9 +82,29 @@ func beforeAction(c *cli.Context) error {
 		}
 	}
 
+	name := profileName(c)
+	if name != "" {
+		if activeProfile = viper.Sub(profileKey(name)); activeProfile == nil {
+			// profile can be created by set command and managed by profile commands
+			if cmd := c.Args().First(); cmd != "set" && cmd != "profile" {
+				return errors.Errorf("profile %q not found in config file %q", name, cfg)
+			}
+		}
+	}
+
 	items := map[string]string{
-		KeyCfgValue:  keyFlag,
-		HostCfgValue: hostFlag,
+		KeyCfgValue:         keyFlag,
+		AddressCfgValue:     addressFlag,
+		HostCfgValue:        hostFlag,
+		TTLCfgValue:         ttlFlag,
+		XHeadersCfgValue:    extHdrFlag,
+		DialTimeoutCfgValue: dialTimeoutFlag,
+
+		TLSCfgValue:                tlsFlag,
+		CACertCfgValue:             caCertFlag,
+		ClientCertCfgValue:         clientCertFlag,
+		ClientKeyCfgValue:          clientKeyFlag,
+		InsecureSkipVerifyCfgValue: insecureSkipVerifyFlag,
 	}
 
 	for key, flag := range items {
@@ -60,7 +113,31 @@ func beforeAction(c *cli.Context) error {
 			continue
 		}
 
-		if value := viper.GetString(key); value != "" {
+		var (
+			values []string
+			src    *viper.Viper
+		)
+
+		switch {
+		case activeProfile != nil && activeProfile.IsSet(key):
+			src = activeProfile
+		case key == KeyCfgValue || key == HostCfgValue:
+			src = viper.GetViper()
+		default:
+			continue
+		}
+
+		if key == XHeadersCfgValue {
+			values = src.GetStringSlice(key)
+		} else {
+			values = []string{src.GetString(key)}
+		}
+
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+
 			if err := c.Set(flag, value); err != nil {
 				fmt.Printf("could not set value for %q from config: %s\n", flag, err)
 			}
@@ -70,6 +147,30 @@ func beforeAction(c *cli.Context) error {
 	return nil
 }
 
+// profileName returns name of the profile selected by flag
+// or environment variable, or the current one from config file.
+func profileName(c *cli.Context) string {
+	if name := c.String(profileFlag); name != "" {
+		return name
+	}
+
+	return viper.GetString(ProfileCfgValue)
+}
+
+func profileKey(name string) string {
+	return ProfilesCfgValue + "." + name
+}
+
+// profileDuration returns duration from the flag if it is set
+// or from the active profile.
+func profileDuration(c *cli.Context, flag, key string) time.Duration {
+	if !c.IsSet(flag) && activeProfile != nil && activeProfile.IsSet(key) {
+		return activeProfile.GetDuration(key)
+	}
+
+	return c.Duration(flag)
+}
+
 func setCommand(mode setMode) cli.ActionFunc {
 	return func(ctx *cli.Context) error {
 		value := ctx.Args().First()
@@ -80,12 +181,13 @@ func setCommand(mode setMode) cli.ActionFunc {
 
 		switch mode {
 		case KeyMode:
-			if _, err := crypto.LoadPrivateKey(value); err != nil {
+			// key is only checked, its original value is stored
+			if err := checkConfigKey(ctx, value); err != nil {
 				fmt.Println(err.Error())
 				os.Exit(2)
 			}
-			fmt.Printf("set new value for key: %q\n", value)
-			viper.Set(KeyCfgValue, value)
+			fmt.Println("set new value for key")
+			viper.Set(setterKey(ctx, KeyCfgValue), value)
 			return viper.WriteConfig()
 		case HostMode:
 			value, err := parseHostValue(value)
@@ -94,7 +196,7 @@ func setCommand(mode setMode) cli.ActionFunc {
 				os.Exit(2)
 			}
 			fmt.Printf("set new value for host: %q\n", value)
-			viper.Set(HostCfgValue, value)
+			viper.Set(setterKey(ctx, HostCfgValue), value)
 			return viper.WriteConfig()
 		default:
 			fmt.Println("unknown setter type")
@@ -105,15 +207,100 @@ func setCommand(mode setMode) cli.ActionFunc {
 	}
 }
 
+// setterKey returns config key of the setting in the selected profile
+// or the default one if no profile is selected.
+func setterKey(c *cli.Context, key string) string {
+	if name := profileName(c); name != "" {
+		return profileKey(name) + "." + key
+	}
+
+	return key
+}
+
 func connect(ctx context.Context, c *cli.Context) (*grpc.ClientConn, error) {
 	if c.Bool(verboseFlag) {
 		log := grpclog.NewLoggerV2WithVerbosity(os.Stdin, os.Stdin, os.Stderr, 40)
 		grpclog.SetLoggerV2(log)
 	}
 
+	if timeout := c.Duration(dialTimeoutFlag); timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	transport, err := transportOption(c)
+	if err != nil {
+		return nil, err
+	}
+
 	return grpc.DialContext(ctx, getHost(c),
 		grpc.WithBlock(),
-		grpc.WithInsecure())
+		transport)
+}
+
+// transportOption returns TLS credentials if it is enabled by flags
+// or by grpcs:// host scheme, otherwise insecure transport is used.
+func transportOption(c *cli.Context) (grpc.DialOption, error) {
+	var (
+		caCert     = c.String(caCertFlag)
+		clientCert = c.String(clientCertFlag)
+		clientKey  = c.String(clientKeyFlag)
+		skipVerify = c.Bool(insecureSkipVerifyFlag)
+
+		useTLS = c.Bool(tlsFlag) || caCert != "" || clientCert != "" || clientKey != "" || skipVerify
+	)
+
+	scheme, addr := splitHostScheme(c.String(hostFlag))
+
+	switch scheme {
+	case grpcsScheme:
+		useTLS = true
+	case grpcScheme:
+		if useTLS {
+			return nil, errors.Errorf("TLS options can't be used with %s:// host address", grpcScheme)
+		}
+	}
+
+	if !useTLS {
+		return grpc.WithInsecure(), nil
+	}
+
+	cfg := &tls.Config{
+		InsecureSkipVerify: skipVerify,
+	}
+
+	// host is resolved into IP address, so server name is taken from the original value
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		cfg.ServerName = host
+	}
+
+	if caCert != "" {
+		data, err := ioutil.ReadFile(caCert)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read CA certificate %s", caCert)
+		}
+
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(data) {
+			return nil, errors.Errorf("could not parse CA certificate %s", caCert)
+		}
+	}
+
+	switch {
+	case clientCert != "" && clientKey != "":
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load client certificate")
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	case clientCert != "" || clientKey != "":
+		return nil, errors.Errorf("--%s and --%s must be used together", clientCertFlag, clientKeyFlag)
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(cfg)), nil
 }
 
 func gracefulContext() context.Context {
@@ -135,18 +322,52 @@ func gracefulContext() context.Context {
 	return ctx
 }
 
+// splitHostScheme cuts grpc:// or grpcs:// scheme from the host address.
+func splitHostScheme(val string) (string, string) {
+	for _, scheme := range []string{grpcScheme, grpcsScheme} {
+		if prefix := scheme + "://"; strings.HasPrefix(val, prefix) {
+			return scheme, strings.TrimPrefix(val, prefix)
+		}
+	}
+
+	return "", val
+}
+
+// parseHostValue resolves host address keeping its scheme.
+// Host name of TLS address is kept to verify server certificate.
 func parseHostValue(val string) (string, error) {
+	scheme, addr, err := parseHost(val)
+	switch {
+	case err != nil:
+		return "", err
+	case scheme == grpcsScheme:
+		return val, nil
+	case scheme != "":
+		return scheme + "://" + addr, nil
+	default:
+		return addr, nil
+	}
+}
+
+// parseHost returns scheme and resolved address of the host.
+func parseHost(val string) (string, string, error) {
+	scheme, val := splitHostScheme(val)
+
+	if strings.Contains(val, "://") {
+		return "", "", errors.Errorf("unsupported scheme, expected %s:// or %s://: %q", grpcScheme, grpcsScheme, val)
+	}
+
 	host, port, err := net.SplitHostPort(val)
 	if err != nil {
-		return "", errors.Wrapf(err, "could not fetch host/port: %q", val)
+		return "", "", errors.Wrapf(err, "could not fetch host/port: %q", val)
 	} else if host == "" {
 		host = "0.0.0.0"
 	}
 
 	addr, err := net.ResolveIPAddr("ip", host)
 	if err != nil {
-		return "", errors.Wrapf(err, `could not resolve address: "%s:%s"`, host, port)
+		return "", "", errors.Wrapf(err, `could not resolve address: "%s:%s"`, host, port)
 	}
 
-	return net.JoinHostPort(addr.IP.String(), port), nil
+	return scheme, net.JoinHostPort(addr.IP.String(), port), nil
 }
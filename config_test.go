@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func Test_parseHostValue(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{value: "127.0.0.1:8080", result: "127.0.0.1:8080"},
+		{value: ":8080", result: "0.0.0.0:8080"},
+		{value: "grpc://127.0.0.1:8080", result: "grpc://127.0.0.1:8080"},
+		{value: "grpcs://localhost:443", result: "grpcs://localhost:443"},
+	}
+
+	for _, tt := range tests {
+		res, err := parseHostValue(tt.value)
+		require.NoError(t, err, tt.value)
+		require.Equal(t, tt.result, res)
+	}
+
+	for _, value := range []string{"127.0.0.1", "http://127.0.0.1:80", "grpcs://127.0.0.1"} {
+		_, err := parseHostValue(value)
+		require.Error(t, err, value)
+	}
+}
+
+func Test_transportOption(t *testing.T) {
+	newContext := func(t *testing.T, args ...string) *cli.Context {
+		return newTestContext(t, []cli.Flag{hostAddr, tlsF, caCert, clientCert, clientKey, insecureSkipVerify}, args...)
+	}
+
+	for _, args := range [][]string{
+		{"--host", "127.0.0.1:8080"},
+		{"--host", "grpcs://localhost:443"},
+		{"--host", "localhost:443", "--tls"},
+		{"--host", "localhost:443", "--insecure-skip-verify"},
+	} {
+		opt, err := transportOption(newContext(t, args...))
+		require.NoError(t, err, args)
+		require.NotNil(t, opt)
+	}
+
+	for _, args := range [][]string{
+		{"--host", "grpc://127.0.0.1:8080", "--tls"},
+		{"--host", "localhost:443", "--client-cert", "./cert.pem"},
+		{"--host", "localhost:443", "--ca-cert", "./config_test.go"},
+		{"--host", "localhost:443", "--ca-cert", "./not-exists.pem"},
+	} {
+		_, err := transportOption(newContext(t, args...))
+		require.Error(t, err, args)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const yesFlag = "yes"
+
+var assumeYes = &cli.BoolFlag{
+	Name:    yesFlag,
+	Aliases: []string{"y"},
+	Usage:   "do not ask for confirmation",
+}
+
+// confirm asks user to confirm the action in terminal,
+// unless --yes flag is set.
+func confirm(c *cli.Context, prompt string) (bool, error) {
+	if c.Bool(yesFlag) {
+		return true, nil
+	}
+
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return false, errors.Errorf("confirmation is required, run in terminal or use --%s", yesFlag)
+	}
+
+	return askConfirmation(os.Stdin, os.Stderr, prompt)
+}
+
+// askConfirmation writes prompt and reads y/N answer, anything
+// but "y" or "yes" is treated as refusal.
+func askConfirmation(r io.Reader, w io.Writer, prompt string) (bool, error) {
+	if _, err := fmt.Fprintf(w, "%s [y/N]: ", prompt); err != nil {
+		return false, err
+	}
+
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return false, errors.Wrap(err, "could not read confirmation")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
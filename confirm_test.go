@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_askConfirmation(t *testing.T) {
+	for input, res := range map[string]bool{
+		"y\n":   true,
+		"Yes\n": true,
+		" y ":   true,
+		"n\n":   false,
+		"\n":    false,
+		"yep\n": false,
+	} {
+		buf := new(bytes.Buffer)
+
+		ok, err := askConfirmation(strings.NewReader(input), buf, "Continue?")
+		require.NoError(t, err, input)
+		require.Equal(t, res, ok, input)
+		require.Equal(t, "Continue? [y/N]: ", buf.String())
+	}
+
+	_, err := askConfirmation(strings.NewReader(""), new(bytes.Buffer), "Continue?")
+	require.Error(t, err)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
-	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/nspcc-dev/neofs-api-go/container"
@@ -18,6 +20,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -28,21 +32,20 @@ const (
 	timeoutFlag = "timeout"
 
 	defaultCapacity = 1
-
-	publicContainerACLRule   = 0x1FFFFFFF
-	privateContainerACLRule  = 0x18888888
-	readonlyContainerACLRule = 0x1FFF88FF
 )
 
+// errUnsignedEACL is returned for non-empty extended ACL table
+// received without signature.
+var errUnsignedEACL = errors.New("extended ACL table is not signed")
+
 var (
 	containerAction    = &action{}
 	putContainerAction = &action{
 		Action: putContainer,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
-				Required: true,
-				Name:     ruleFlag,
-				Usage:    "container rules",
+				Name:  ruleFlag,
+				Usage: "container rules",
 			},
 			&cli.Uint64Flag{
 				Name:  capFlag,
@@ -51,14 +54,34 @@ var (
 			},
 			&cli.StringFlag{
 				Name:  aclFlag,
-				Usage: "basic ACL: public, private, readonly or 32-bit hex",
-				Value: "private",
+				Usage: "basic ACL: public, private, readonly, 32-bit hex or rules like 'get,head:others;put:user'",
+				Value: defaultBasicACL,
+			},
+			&cli.StringFlag{
+				Name:  specFlag,
+				Usage: "path to the yaml or json container spec file",
 			},
 			&cli.DurationFlag{
 				Name:  timeoutFlag,
 				Usage: "create container timeout",
 				Value: time.Minute * 2,
 			},
+			waitRequest,
+			noWaitRequest,
+		},
+	}
+	applyContainerAction = &action{
+		Action: applyContainer,
+		Flags: []cli.Flag{
+			containerID,
+			&cli.StringFlag{
+				Name:  specFlag,
+				Usage: "path to the yaml or json container spec file",
+			},
+			&cli.BoolFlag{
+				Name:  dryRunFlag,
+				Usage: "only report drift, don't update extended ACL",
+			},
 		},
 	}
 	getContainerAction = &action{
@@ -71,6 +94,13 @@ var (
 		Action: delContainer,
 		Flags: []cli.Flag{
 			containerID,
+			&cli.DurationFlag{
+				Name:  timeoutFlag,
+				Usage: "delete container timeout",
+				Value: time.Minute * 2,
+			},
+			waitRequest,
+			noWaitRequest,
 		},
 	}
 	listContainersAction = &action{
@@ -81,6 +111,7 @@ var (
 		Flags: []cli.Flag{
 			containerID,
 			eacl,
+			eaclRules,
 		},
 		Action: setContainerEACL,
 	}
@@ -88,6 +119,11 @@ var (
 	getContainerEACLAction = &action{
 		Flags: []cli.Flag{
 			containerID,
+			&cli.StringFlag{
+				Name:  formatFlag,
+				Usage: "extended ACL format: hex or rules",
+				Value: eaclFormatHex,
+			},
 		},
 		Action: getContainerEACL,
 	}
@@ -95,27 +131,36 @@ var (
 
 func putContainer(c *cli.Context) error {
 	var (
-		err      error
-		basicACL uint64
-		key      = getKey(c)
-		host     = getHost(c)
-		msgID    refs.MessageID
-		conn     *grpc.ClientConn
-		ctx      = gracefulContext()
-		cCap     = c.Uint64(capFlag)
-		sRule    = c.String(ruleFlag)
-		sACL     = strings.TrimLeft(c.String(aclFlag), "0x")
-		plRule   *netmap.PlacementRule
-
-		createTimeout = c.Duration(timeoutFlag)
+		err   error
+		prm   *containerParams
+		key   = getKey(c)
+		host  = getHost(c)
+		msgID refs.MessageID
+		conn  *grpc.ClientConn
+		ctx   = gracefulContext()
+		spec  = c.String(specFlag)
 	)
 
-	if sRule == "" || cCap == 0 {
-		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	switch {
+	case spec != "" && (c.IsSet(ruleFlag) || c.IsSet(capFlag) || c.IsSet(aclFlag)):
+		return errors.Errorf("--%s can't be used with --%s, --%s and --%s", specFlag, ruleFlag, capFlag, aclFlag)
+	case spec != "":
+		if prm, err = containerParamsFromFile(spec); err != nil {
+			return err
+		}
+	default:
+		if prm, err = containerParamsFromFlags(c); err != nil {
+			return err
+		}
 	}
 
-	if plRule, err = query.ParseQuery(sRule); err != nil {
-		return errors.Wrapf(err, "placement rule parse failed %s", sRule)
+	// explicit flags override wait options from the spec
+	if prm.timeout == 0 || c.IsSet(timeoutFlag) {
+		prm.timeout = profileDuration(c, timeoutFlag, TimeoutCfgValue)
+	}
+
+	if prm.wait = shouldWait(c, prm.wait); !prm.wait && prm.eacl != nil {
+		return errors.New("extended ACL can be set only if container creation is awaited")
 	}
 
 	if conn, err = connect(ctx, c); err != nil {
@@ -126,20 +171,6 @@ func putContainer(c *cli.Context) error {
 		return errors.Wrap(err, "could not create message ID")
 	}
 
-	switch sACL {
-	case "public":
-		basicACL = publicContainerACLRule
-	case "private":
-		basicACL = privateContainerACLRule
-	case "readonly":
-		basicACL = readonlyContainerACLRule
-	default:
-		basicACL, err = strconv.ParseUint(sACL, 16, 32)
-		if err != nil {
-			return errors.Wrap(err, "incorrect basic ACL")
-		}
-	}
-
 	owner, err := refs.NewOwnerID(&key.PublicKey)
 	if err != nil {
 		return errors.Wrap(err, "could not compute owner ID")
@@ -147,10 +178,10 @@ func putContainer(c *cli.Context) error {
 
 	req := &container.PutRequest{
 		MessageID: msgID,
-		Capacity:  cCap * uint64(object.UnitsGB),
+		Capacity:  prm.capacity * uint64(object.UnitsGB),
 		OwnerID:   owner,
-		Rules:     *plRule,
-		BasicACL:  uint32(basicACL),
+		Rules:     *prm.rule,
+		BasicACL:  prm.basicACL,
 	}
 
 	setTTL(c, req)
@@ -162,50 +193,128 @@ func putContainer(c *cli.Context) error {
 		return errors.Wrap(err, "put request failed")
 	}
 
-	fmt.Printf("Container processed: %s\n\n", resp.CID)
-	fmt.Println("Trying to wait until container will be accepted on consensus...")
+	out := containerPutOutput{ID: resp.CID.String()}
+
+	fmt.Fprintf(messageWriter(c), "Container processed: %s\n\n", resp.CID)
+
+	if prm.wait {
+		if err = waitContainer(ctx, c, conn, owner, resp.CID, prm.timeout); err != nil {
+			return err
+		}
+
+		out.Accepted = true
+	}
+
+	if prm.eacl != nil {
+		fmt.Fprintln(os.Stderr, "Setting extended ACL rules of container...")
+
+		if err = sendContainerEACL(ctx, c, conn, resp.CID, prm.eacl); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(messageWriter(c), "Extended ACL rules was successfully set.")
+
+		out.EACLSet = true
+	}
+
+	return printOutput(c, out, skipText)
+}
+
+func containerParamsFromFlags(c *cli.Context) (*containerParams, error) {
+	var (
+		err   error
+		cCap  = c.Uint64(capFlag)
+		sRule = c.String(ruleFlag)
+		prm   = &containerParams{capacity: cCap, wait: true}
+	)
+
+	if sRule == "" || cCap == 0 {
+		return nil, errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if prm.rule, err = query.ParseQuery(sRule); err != nil {
+		return nil, errors.Wrapf(err, "placement rule parse failed %s", sRule)
+	}
+
+	if prm.basicACL, err = parseBasicACL(c.String(aclFlag)); err != nil {
+		return nil, err
+	}
+
+	return prm, nil
+}
+
+func containerParamsFromFile(path string) (*containerParams, error) {
+	spec, err := readContainerSpec(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return containerParamsFromSpec(spec)
+}
 
-	ticker := time.NewTicker(time.Second)
-	defer ticker.Stop()
+// waitContainer awaits until the container appears in the container list
+// of the owner.
+func waitContainer(ctx context.Context, c *cli.Context, conn *grpc.ClientConn, owner refs.OwnerID, cid refs.CID, timeout time.Duration) error {
+	fmt.Fprintln(os.Stderr, "Trying to wait until container will be accepted on consensus...")
 
-	// store response CID
-	cid := resp.CID
 	client := container.NewServiceClient(conn)
 
-	ctx, cancel := context.WithTimeout(ctx, createTimeout)
-	defer cancel()
-
-loop:
-	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println()
-			fmt.Println("Timeout exceeded! Something went wrong.")
-			fmt.Println("Try to find your container by command `container list` or retry in few minutes.")
-			os.Exit(2)
-		case <-ticker.C:
-			fmt.Printf("...")
-
-			req := &container.ListRequest{OwnerID: owner}
-			setTTL(c, req)
-			setRaw(c, req)
-			signRequest(c, req)
-
-			resp, err := client.List(ctx, req)
-			if err != nil {
-				continue loop
-			}
+	err := await(ctx, timeout, func(ctx context.Context) (bool, error) {
+		awaitProgress()
 
-			for i := range resp.CID {
-				if resp.CID[i].Equal(cid) {
-					fmt.Printf("\nSuccess! Container <%s> created.\n", cid)
+		req := &container.ListRequest{OwnerID: owner}
+		setTTL(c, req)
+		setRaw(c, req)
+		signRequest(c, req)
 
-					break loop
-				}
+		resp, err := client.List(ctx, req)
+		if err != nil {
+			// node may be temporarily unavailable, try again later
+			return false, nil
+		}
+
+		for i := range resp.CID {
+			if resp.CID[i].Equal(cid) {
+				return true, nil
 			}
 		}
+
+		return false, nil
+	})
+
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		return errors.Wrapf(err, "container %s wasn't accepted in %s, "+
+			"try to find it by command `container list` later", cid, timeout)
+	}
+
+	fmt.Fprintf(messageWriter(c), "Success! Container <%s> created.\n", cid)
+
+	return nil
+}
+
+// waitContainerDeletion awaits until the container can't be found in the network.
+func waitContainerDeletion(ctx context.Context, c *cli.Context, conn *grpc.ClientConn, cid refs.CID, timeout time.Duration) error {
+	fmt.Fprintln(os.Stderr, "Trying to wait until container will be removed on consensus...")
+
+	err := await(ctx, timeout, func(ctx context.Context) (bool, error) {
+		awaitProgress()
+
+		_, err := fetchContainer(ctx, conn, cid, c)
+
+		return status.Code(err) == codes.NotFound, nil
+	})
+
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		return errors.Wrapf(err, "container %s wasn't removed in %s, "+
+			"check it by command `container get` later", cid, timeout)
 	}
 
+	fmt.Fprintf(messageWriter(c), "Success! Container <%s> removed.\n", cid)
+
 	return nil
 }
 
@@ -283,12 +392,137 @@ func getContainer(c *cli.Context) error {
 		return errors.Wrap(err, "can't perform request")
 	}
 
-	fmt.Printf("Container ID: %s\n", cid)
-	fmt.Printf("Owner ID    : %s\n", resp.Container.OwnerID)
-	fmt.Printf("Capacity    : %s\n", object.ByteSize(resp.Container.Capacity))
-	fmt.Printf("Placement   : %s\n", placementStringify(&resp.Container.Rules))
-	fmt.Printf("Salt        : %s\n", resp.Container.Salt)
-	fmt.Printf("BasicACL    : %08x\n", resp.Container.BasicACL)
+	out := containerOutput{
+		ID:        cid.String(),
+		OwnerID:   resp.Container.OwnerID.String(),
+		Capacity:  resp.Container.Capacity,
+		Placement: placementStringify(&resp.Container.Rules),
+		Salt:      resp.Container.Salt.String(),
+		BasicACL:  fmt.Sprintf("%08x", resp.Container.BasicACL),
+
+		Permissions: basicACLOutputFrom(resp.Container.BasicACL),
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Container ID: %s\n"+
+			"Owner ID    : %s\n"+
+			"Capacity    : %s\n"+
+			"Placement   : %s\n"+
+			"Salt        : %s\n"+
+			"BasicACL    : %s\n",
+			out.ID,
+			out.OwnerID,
+			object.ByteSize(out.Capacity),
+			out.Placement,
+			out.Salt,
+			out.BasicACL)
+		if err != nil {
+			return err
+		}
+
+		return displayBasicACL(w, out.Permissions)
+	})
+}
+
+func applyContainer(c *cli.Context) error {
+	var (
+		err    error
+		cid    refs.CID
+		prm    *containerParams
+		host   = getHost(c)
+		conn   *grpc.ClientConn
+		sCID   = c.String(cidFlag)
+		spec   = c.String(specFlag)
+		dryRun = c.Bool(dryRunFlag)
+		ctx    = gracefulContext()
+	)
+
+	if sCID == "" || spec == "" {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if cid, err = refs.CIDFromString(sCID); err != nil {
+		return errors.Wrapf(err, "can't parse CID %s", sCID)
+	}
+
+	if prm, err = containerParamsFromFile(spec); err != nil {
+		return err
+	}
+
+	if conn, err = connect(ctx, c); err != nil {
+		return errors.Wrapf(err, "can't connect to host '%s'", host)
+	}
+
+	resp, err := fetchContainer(ctx, conn, cid, c)
+	if err != nil {
+		return errors.Wrap(err, "can't perform request")
+	}
+
+	var eacl []byte
+
+	if prm.eacl != nil {
+		// unsigned table is replaced by the one from spec
+		if eacl, err = fetchContainerEACL(ctx, c, conn, cid); err != nil && errors.Cause(err) != errUnsignedEACL {
+			return err
+		}
+	}
+
+	out := containerApplyOutput{
+		ID:    cid.String(),
+		Drift: containerDrift(prm, resp.Container, eacl),
+	}
+
+	// immutable fields can be changed only by creating new container
+	var immutable int
+
+	for _, d := range out.Drift {
+		if d.Field != driftFieldEACL {
+			immutable++
+		}
+	}
+
+	err = printOutput(c, out, func(w io.Writer) error {
+		if len(out.Drift) == 0 {
+			_, err := fmt.Fprintf(w, "Container %s matches spec\n", out.ID)
+			return err
+		}
+
+		tw := tabwriter.NewWriter(w, 1, 8, 3, ' ', 0)
+
+		if _, err := fmt.Fprintf(tw, "Container %s differs from spec:\n", out.ID); err != nil {
+			return err
+		} else if _, err := fmt.Fprintln(tw, "FIELD\tSPEC\tLIVE"); err != nil {
+			return err
+		}
+
+		for _, d := range out.Drift {
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", d.Field, d.Spec, d.Live); err != nil {
+				return err
+			}
+		}
+
+		return tw.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(out.Drift) > immutable && !dryRun {
+		fmt.Fprintln(os.Stderr, "Updating ACL rules of container...")
+
+		if err = sendContainerEACL(ctx, c, conn, cid, prm.eacl); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(messageWriter(c), "Extended ACL rules was successfully updated.")
+	}
+
+	switch {
+	case immutable > 0:
+		return errors.Errorf("container differs from spec in %d immutable field(s), create new container to apply them", immutable)
+	case dryRun && len(out.Drift) > 0:
+		return errors.Errorf("container differs from spec in %d field(s)", len(out.Drift))
+	}
 
 	return nil
 }
@@ -320,9 +554,23 @@ func delContainer(c *cli.Context) error {
 	setRaw(c, req)
 	signRequest(c, req)
 
-	_, err = container.NewServiceClient(conn).Delete(ctx, req)
+	if _, err = container.NewServiceClient(conn).Delete(ctx, req); err != nil {
+		return errors.Wrap(err, "can't perform request")
+	}
+
+	out := containerDeleteOutput{ID: cid.String()}
 
-	return errors.Wrap(err, "can't perform request")
+	fmt.Fprintf(messageWriter(c), "Container deletion submitted: %s\n\n", cid)
+
+	if shouldWait(c, true) {
+		if err = waitContainerDeletion(ctx, c, conn, cid, profileDuration(c, timeoutFlag, TimeoutCfgValue)); err != nil {
+			return err
+		}
+
+		out.Removed = true
+	}
+
+	return printOutput(c, out, skipText)
 }
 
 func listContainers(c *cli.Context) error {
@@ -353,12 +601,27 @@ func listContainers(c *cli.Context) error {
 		return errors.Wrapf(err, "can't complete request")
 	}
 
-	fmt.Println("Container ID")
+	out := containerListOutput{
+		Containers: make([]string, 0, len(resp.CID)),
+	}
+
 	for i := range resp.CID {
-		fmt.Println(resp.CID[i])
+		out.Containers = append(out.Containers, resp.CID[i].String())
 	}
 
-	return nil
+	return printOutput(c, out, func(w io.Writer) error {
+		if _, err := fmt.Fprintln(w, "Container ID"); err != nil {
+			return err
+		}
+
+		for i := range out.Containers {
+			if _, err := fmt.Fprintln(w, out.Containers[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 func setContainerEACL(c *cli.Context) error {
@@ -366,16 +629,15 @@ func setContainerEACL(c *cli.Context) error {
 		err   error
 		cid   refs.CID
 		eacl  []byte
-		sig   []byte
-		key   = getKey(c)
 		host  = getHost(c)
 		conn  *grpc.ClientConn
 		sCID  = c.String(cidFlag)
 		sEACL = c.String(eaclFlag)
+		rules = c.String(rulesFlag)
 		ctx   = gracefulContext()
 	)
 
-	if sCID == "" {
+	if sCID == "" || (sEACL == "") == (rules == "") {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
 	}
 
@@ -383,8 +645,17 @@ func setContainerEACL(c *cli.Context) error {
 		return errors.Wrapf(err, "can't parse CID %s", sCID)
 	}
 
-	switch sEACL {
-	case "empty":
+	switch {
+	case rules != "":
+		data, err := ioutil.ReadFile(rules)
+		if err != nil {
+			return errors.Wrapf(err, "could not read rules file %s", rules)
+		}
+
+		if eacl, err = compileEACL(data, eaclFormatFromPath(rules)); err != nil {
+			return errors.Wrap(err, "could not compile extended ACL")
+		}
+	case sEACL == "empty":
 		eacl = make([]byte, 0)
 	default:
 		if eacl, err = hex.DecodeString(sEACL); err != nil {
@@ -392,31 +663,17 @@ func setContainerEACL(c *cli.Context) error {
 		}
 	}
 
-	if sig, err = crypto.SignRFC6979(key, eacl); err != nil {
-		return errors.Wrap(err, "could not sign extended ACL")
-	}
-
 	if conn, err = connect(ctx, c); err != nil {
 		return errors.Wrapf(err, "can't connect to host '%s'", host)
 	}
 
-	req := new(container.SetExtendedACLRequest)
-	req.SetID(cid)
-	req.SetEACL(eacl)
-	req.SetSignature(sig)
-
-	setTTL(c, req)
-	setRaw(c, req)
-	signRequest(c, req)
-
-	fmt.Println("Updating ACL rules of container...")
+	fmt.Fprintln(os.Stderr, "Updating ACL rules of container...")
 
-	_, err = container.NewServiceClient(conn).SetExtendedACL(ctx, req)
-	if err != nil {
-		return errors.Wrapf(err, "can't complete request")
+	if err = sendContainerEACL(ctx, c, conn, cid, eacl); err != nil {
+		return err
 	}
 
-	fmt.Println("Extended ACL rules was successfully updated.")
+	fmt.Fprintln(messageWriter(c), "Extended ACL rules was successfully updated.")
 
 	return nil
 }
@@ -425,10 +682,10 @@ func getContainerEACL(c *cli.Context) error {
 	var (
 		err  error
 		cid  refs.CID
-		key  = getKey(c)
 		host = getHost(c)
 		conn *grpc.ClientConn
 		sCID = c.String(cidFlag)
+		frmt = c.String(formatFlag)
 		ctx  = gracefulContext()
 	)
 
@@ -436,6 +693,12 @@ func getContainerEACL(c *cli.Context) error {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
 	}
 
+	switch frmt {
+	case eaclFormatHex, eaclFormatRules:
+	default:
+		return errors.Errorf("unknown extended ACL format: %q", frmt)
+	}
+
 	if cid, err = refs.CIDFromString(sCID); err != nil {
 		return errors.Wrapf(err, "can't parse CID %s", sCID)
 	}
@@ -444,25 +707,85 @@ func getContainerEACL(c *cli.Context) error {
 		return errors.Wrapf(err, "can't connect to host '%s'", host)
 	}
 
-	req := new(container.GetExtendedACLRequest)
+	fmt.Fprintln(os.Stderr, "Waiting for ACL rules of container...")
+
+	eacl, err := fetchContainerEACL(ctx, c, conn, cid)
+	if err != nil {
+		return err
+	}
+
+	if frmt == eaclFormatHex {
+		out := containerEACLOutput{EACL: hex.EncodeToString(eacl)}
+
+		return printOutput(c, out, func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "Extended container ACL table: %s\n", out.EACL)
+			return err
+		})
+	}
+
+	rules, err := eaclRulesFrom(eacl)
+	if err != nil {
+		return errors.Wrap(err, "could not decode extended ACL")
+	}
+
+	out := containerEACLOutput{Records: rules.Records}
+
+	// rules are printed as yaml rules file in text format
+	return printOutput(c, out, func(w io.Writer) error {
+		return writeOutput(w, outputYAML, out, nil)
+	})
+}
+
+// sendContainerEACL signs extended ACL table with the key of container owner
+// and sends it to the network.
+func sendContainerEACL(ctx context.Context, c *cli.Context, conn *grpc.ClientConn, cid refs.CID, eacl []byte) error {
+	sig, err := crypto.SignRFC6979(getKey(c), eacl)
+	if err != nil {
+		return errors.Wrap(err, "could not sign extended ACL")
+	}
+
+	req := new(container.SetExtendedACLRequest)
 	req.SetID(cid)
+	req.SetEACL(eacl)
+	req.SetSignature(sig)
 
 	setTTL(c, req)
 	setRaw(c, req)
 	signRequest(c, req)
 
-	fmt.Println("Waiting for ACL rules of container...")
+	if _, err = container.NewServiceClient(conn).SetExtendedACL(ctx, req); err != nil {
+		return errors.Wrapf(err, "can't complete request")
+	}
+
+	return nil
+}
+
+// fetchContainerEACL receives extended ACL table of the container
+// and checks its signature with the key of container owner.
+func fetchContainerEACL(ctx context.Context, c *cli.Context, conn *grpc.ClientConn, cid refs.CID) ([]byte, error) {
+	req := new(container.GetExtendedACLRequest)
+	req.SetID(cid)
+
+	setTTL(c, req)
+	setRaw(c, req)
+	signRequest(c, req)
 
 	resp, err := container.NewServiceClient(conn).GetExtendedACL(ctx, req)
 	if err != nil {
-		return errors.Wrapf(err, "can't complete request")
+		return nil, errors.Wrapf(err, "can't complete request")
 	}
 
-	if err := crypto.VerifyRFC6979(&key.PublicKey, resp.GetEACL(), resp.GetSignature()); err != nil {
-		return errors.Wrap(err, "could not verify signature")
+	switch {
+	case len(resp.GetEACL()) == 0 && len(resp.GetSignature()) == 0:
+		// extended ACL of the container is not set yet
+		return nil, nil
+	case len(resp.GetSignature()) == 0:
+		return nil, errUnsignedEACL
 	}
 
-	fmt.Printf("Extended container ACL table: %s\n", hex.EncodeToString(resp.GetEACL()))
+	if err := crypto.VerifyRFC6979(&getKey(c).PublicKey, resp.GetEACL(), resp.GetSignature()); err != nil {
+		return nil, errors.Wrap(err, "could not verify signature")
+	}
 
-	return nil
+	return resp.GetEACL(), nil
 }
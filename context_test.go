@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newTestContext returns command context with the flags parsed from args.
+func newTestContext(t *testing.T, flags []cli.Flag, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	for _, f := range flags {
+		require.NoError(t, f.Apply(set))
+	}
+
+	require.NoError(t, set.Parse(args))
+
+	return cli.NewContext(cli.NewApp(), set, nil)
+}
+
+// captureStdout returns data written into standard output by f.
+func captureStdout(t *testing.T, f func()) string {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = w
+
+	defer func() { os.Stdout = stdout }()
+
+	data := make(chan []byte)
+
+	go func() {
+		res, _ := ioutil.ReadAll(r)
+		data <- res
+	}()
+
+	f()
+
+	require.NoError(t, w.Close())
+
+	return string(<-data)
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	extended "github.com/nspcc-dev/neofs-api-go/acl/extended"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// eaclRulesTable is a human-readable representation of extended ACL table.
+	eaclRulesTable struct {
+		Records []eaclRulesRecord `json:"records" yaml:"records"`
+	}
+
+	eaclRulesRecord struct {
+		Operation string            `json:"operation" yaml:"operation"`
+		Action    string            `json:"action" yaml:"action"`
+		Filters   []eaclRulesFilter `json:"filters,omitempty" yaml:"filters,omitempty"`
+		Targets   []eaclRulesTarget `json:"targets" yaml:"targets"`
+	}
+
+	eaclRulesFilter struct {
+		Header string `json:"header" yaml:"header"`
+		Match  string `json:"match" yaml:"match"`
+		Name   string `json:"name" yaml:"name"`
+		Value  string `json:"value" yaml:"value"`
+	}
+
+	eaclRulesTarget struct {
+		Role string   `json:"role,omitempty" yaml:"role,omitempty"`
+		Keys []string `json:"keys,omitempty" yaml:"keys,omitempty"`
+	}
+)
+
+const (
+	eaclFormatHex   = "hex"
+	eaclFormatRules = "rules"
+	eaclFormatYAML  = "yaml"
+	eaclFormatJSON  = "json"
+)
+
+var (
+	eaclOperations = map[string]extended.OperationType{
+		"get":            extended.OpTypeGet,
+		"put":            extended.OpTypePut,
+		"head":           extended.OpTypeHead,
+		"search":         extended.OpTypeSearch,
+		"delete":         extended.OpTypeDelete,
+		"get-range":      extended.OpTypeRange,
+		"get-range-hash": extended.OpTypeRangeHash,
+	}
+
+	eaclActions = map[string]extended.Action{
+		"allow": extended.ActionAllow,
+		"deny":  extended.ActionDeny,
+	}
+
+	eaclGroups = map[string]extended.Group{
+		"user":   extended.GroupUser,
+		"system": extended.GroupSystem,
+		"others": extended.GroupOthers,
+	}
+
+	eaclHeaderTypes = map[string]extended.HeaderType{
+		"request": extended.HdrTypeRequest,
+		"system":  extended.HdrTypeObjSys,
+		"user":    extended.HdrTypeObjUsr,
+	}
+
+	eaclMatchTypes = map[string]extended.MatchType{
+		"eq": extended.StringEqual,
+		"ne": extended.StringNotEqual,
+	}
+)
+
+// eaclFormatFromPath returns rules file format by its extension.
+func eaclFormatFromPath(path string) string {
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		return eaclFormatJSON
+	}
+
+	return eaclFormatYAML
+}
+
+// compileEACL parses rules file data and encodes it into
+// the binary form of extended ACL table.
+func compileEACL(data []byte, format string) ([]byte, error) {
+	var (
+		err   error
+		rules eaclRulesTable
+	)
+
+	switch format {
+	case eaclFormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&rules)
+	case eaclFormatYAML:
+		err = yaml.UnmarshalStrict(data, &rules)
+	default:
+		return nil, errors.Errorf("unknown rules format: %q", format)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse rules")
+	}
+
+	return compileEACLTable(rules)
+}
+
+// compileEACLTable encodes rules into the binary form of extended ACL table.
+func compileEACLTable(rules eaclRulesTable) ([]byte, error) {
+	records := make([]extended.Record, 0, len(rules.Records))
+
+	for i := range rules.Records {
+		record, err := compileEACLRecord(rules.Records[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid record #%d", i)
+		}
+
+		records = append(records, record)
+	}
+
+	table := extended.WrapTable(nil)
+	table.SetRecords(records)
+
+	return extended.MarshalTable(table), nil
+}
+
+func compileEACLRecord(r eaclRulesRecord) (extended.Record, error) {
+	op, ok := eaclOperations[strings.ToLower(r.Operation)]
+	if !ok {
+		return nil, errors.Errorf("unknown operation: %q", r.Operation)
+	}
+
+	action, ok := eaclActions[strings.ToLower(r.Action)]
+	if !ok {
+		return nil, errors.Errorf("unknown action: %q", r.Action)
+	}
+
+	if len(r.Targets) == 0 {
+		return nil, errors.New("empty target list")
+	}
+
+	filters := make([]extended.HeaderFilter, 0, len(r.Filters))
+
+	for i := range r.Filters {
+		f := r.Filters[i]
+
+		hdrType, ok := eaclHeaderTypes[strings.ToLower(f.Header)]
+		if !ok {
+			return nil, errors.Errorf("unknown filter header type: %q", f.Header)
+		}
+
+		match, ok := eaclMatchTypes[strings.ToLower(f.Match)]
+		if !ok {
+			return nil, errors.Errorf("unknown filter match type: %q", f.Match)
+		}
+
+		filter := extended.WrapFilterInfo(nil)
+		filter.SetHeaderType(hdrType)
+		filter.SetMatchType(match)
+		filter.SetName(f.Name)
+		filter.SetValue(f.Value)
+
+		filters = append(filters, filter)
+	}
+
+	targets := make([]extended.Target, 0, len(r.Targets))
+
+	for i := range r.Targets {
+		t := r.Targets[i]
+
+		target := extended.WrapTarget(nil)
+
+		if t.Role != "" {
+			group, ok := eaclGroups[strings.ToLower(t.Role)]
+			if !ok {
+				return nil, errors.Errorf("unknown target role: %q", t.Role)
+			}
+
+			target.SetGroup(group)
+		} else if len(t.Keys) == 0 {
+			return nil, errors.New("target must contain role or keys")
+		}
+
+		keys := make([][]byte, 0, len(t.Keys))
+
+		for j := range t.Keys {
+			key, err := hex.DecodeString(t.Keys[j])
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not decode target key %q", t.Keys[j])
+			}
+
+			keys = append(keys, key)
+		}
+
+		target.SetKeyList(keys)
+
+		targets = append(targets, target)
+	}
+
+	record := extended.WrapRecord(nil)
+	record.SetOperationType(op)
+	record.SetAction(action)
+	record.SetHeaderFilters(filters)
+	record.SetTargetList(targets)
+
+	return record, nil
+}
+
+// eaclRulesFrom converts binary extended ACL table into rules.
+func eaclRulesFrom(data []byte) (eaclRulesTable, error) {
+	table, err := extended.UnmarshalTable(data)
+	if err != nil {
+		return eaclRulesTable{}, errors.Wrap(err, "could not unmarshal extended ACL table")
+	}
+
+	records := table.Records()
+
+	rules := eaclRulesTable{
+		Records: make([]eaclRulesRecord, 0, len(records)),
+	}
+
+	for _, record := range records {
+		r := eaclRulesRecord{
+			Operation: eaclOperationName(record.OperationType()),
+			Action:    eaclActionName(record.Action()),
+		}
+
+		for _, filter := range record.HeaderFilters() {
+			r.Filters = append(r.Filters, eaclRulesFilter{
+				Header: eaclHeaderTypeName(filter.HeaderType()),
+				Match:  eaclMatchTypeName(filter.MatchType()),
+				Name:   filter.Name(),
+				Value:  filter.Value(),
+			})
+		}
+
+		for _, target := range record.TargetList() {
+			t := eaclRulesTarget{
+				Role: eaclGroupName(target.Group()),
+			}
+
+			for _, key := range target.KeyList() {
+				t.Keys = append(t.Keys, hex.EncodeToString(key))
+			}
+
+			r.Targets = append(r.Targets, t)
+		}
+
+		rules.Records = append(rules.Records, r)
+	}
+
+	return rules, nil
+}
+
+func eaclOperationName(v extended.OperationType) string {
+	for name, op := range eaclOperations {
+		if op == v {
+			return name
+		}
+	}
+
+	return "unknown"
+}
+
+func eaclActionName(v extended.Action) string {
+	for name, action := range eaclActions {
+		if action == v {
+			return name
+		}
+	}
+
+	return "unknown"
+}
+
+func eaclGroupName(v extended.Group) string {
+	for name, group := range eaclGroups {
+		if group == v {
+			return name
+		}
+	}
+
+	// targets without group are described by keys only
+	return ""
+}
+
+func eaclHeaderTypeName(v extended.HeaderType) string {
+	for name, hdrType := range eaclHeaderTypes {
+		if hdrType == v {
+			return name
+		}
+	}
+
+	return "unknown"
+}
+
+func eaclMatchTypeName(v extended.MatchType) string {
+	for name, match := range eaclMatchTypes {
+		if match == v {
+			return name
+		}
+	}
+
+	return "unknown"
+}
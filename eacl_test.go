@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	extended "github.com/nspcc-dev/neofs-api-go/acl/extended"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_compileEACL(t *testing.T) {
+	rules := `records:
+- operation: get
+  action: deny
+  filters:
+  - header: user
+    match: eq
+    name: Classified
+    value: "true"
+  targets:
+  - role: others
+- operation: put
+  action: allow
+  targets:
+  - keys:
+    - 02c4c574d1bbe7efb2feaeed99e6c03924d6d3c9ad76530437d75c07bff3ddcc0f
+`
+
+	data, err := compileEACL([]byte(rules), eaclFormatYAML)
+	require.NoError(t, err)
+
+	table, err := extended.UnmarshalTable(data)
+	require.NoError(t, err)
+
+	records := table.Records()
+	require.Len(t, records, 2)
+
+	require.Equal(t, extended.OpTypeGet, records[0].OperationType())
+	require.Equal(t, extended.ActionDeny, records[0].Action())
+	require.Len(t, records[0].HeaderFilters(), 1)
+	require.Equal(t, extended.HdrTypeObjUsr, records[0].HeaderFilters()[0].HeaderType())
+	require.Equal(t, "Classified", records[0].HeaderFilters()[0].Name())
+	require.Equal(t, extended.GroupOthers, records[0].TargetList()[0].Group())
+
+	require.Equal(t, extended.OpTypePut, records[1].OperationType())
+	require.Len(t, records[1].TargetList()[0].KeyList(), 1)
+
+	t.Run("round trip", func(t *testing.T) {
+		decoded, err := eaclRulesFrom(data)
+		require.NoError(t, err)
+
+		out := containerEACLOutput{Records: decoded.Records}
+
+		for _, format := range []string{eaclFormatYAML, eaclFormatJSON} {
+			buf := new(bytes.Buffer)
+			require.NoError(t, writeOutput(buf, format, out, nil))
+
+			recompiled, err := compileEACL(buf.Bytes(), format)
+			require.NoError(t, err)
+			require.Equal(t, data, recompiled, format)
+		}
+
+		buf := new(bytes.Buffer)
+		require.NoError(t, writeOutput(buf, outputYAML, out, nil))
+		require.Equal(t, rules, buf.String())
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		cases := []string{
+			"records:\n- operation: unknown\n  action: deny\n  targets:\n  - role: others\n",
+			"records:\n- operation: get\n  action: unknown\n  targets:\n  - role: others\n",
+			"records:\n- operation: get\n  action: deny\n",
+			"records:\n- operation: get\n  action: deny\n  targets:\n  - role: unknown\n",
+			"records:\n- operation: get\n  action: deny\n  targets:\n  - keys: [zz]\n",
+			"unknown: field\n",
+		}
+
+		for i := range cases {
+			_, err := compileEACL([]byte(cases[i]), eaclFormatYAML)
+			require.Error(t, err, cases[i])
+		}
+
+		// misspelled field must not be ignored
+		_, err := compileEACL([]byte(`{"records":[{"operation":"get","action":"deny","targets":[{"role":"others","key":["02"]}]}]}`), eaclFormatJSON)
+		require.EqualError(t, err, `could not parse rules: json: unknown field "key"`)
+	})
+}
@@ -4,9 +4,9 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/nspcc-dev/neofs-api-go/service"
-	crypto "github.com/nspcc-dev/neofs-crypto"
 	"github.com/urfave/cli/v2"
 )
 
@@ -27,8 +27,11 @@ const (
 	beautyFlag  = "beauty"
 	stateFlag   = "state"
 	eaclFlag    = "eacl"
+	rulesFlag   = "rules"
+	formatFlag  = "format"
 	bearerFlag  = "bearer"
 	extHdrFlag  = "xhdr"
+	profileFlag = "profile"
 
 	ConfigFlag = "config"
 
@@ -44,7 +47,22 @@ var (
 	keyFile = &cli.StringFlag{
 		Name:    keyFlag,
 		EnvVars: []string{KeyEnvValue},
-		Usage:   "user private key in hex, wif formats or path to file",
+		Usage:   "user private key in hex, wif, NEP-2 formats or path to key file or NEP-6 wallet",
+	}
+
+	walletAddress = &cli.StringFlag{
+		Name:  addressFlag,
+		Usage: "address of NEP-6 wallet account",
+	}
+
+	passphraseFD = &cli.IntFlag{
+		Name:  passphraseFDFlag,
+		Usage: "file descriptor to read passphrases of encrypted keys from, one per line",
+	}
+
+	plainKey = &cli.BoolFlag{
+		Name:  plainKeyFlag,
+		Usage: "allow storing plain hex or WIF private key in config",
 	}
 
 	ttlF = &cli.UintFlag{
@@ -60,9 +78,45 @@ var (
 		Value:   DefaultConfig,
 	}
 
+	profileF = &cli.StringFlag{
+		Name:    profileFlag,
+		Usage:   "name of the profile from config",
+		EnvVars: []string{ProfileEnvValue},
+	}
+
+	dialTimeout = &cli.DurationFlag{
+		Name:  dialTimeoutFlag,
+		Usage: "timeout of connection to the host, 0 for no timeout",
+	}
+
+	tlsF = &cli.BoolFlag{
+		Name:  tlsFlag,
+		Usage: "use TLS transport",
+	}
+
+	caCert = &cli.StringFlag{
+		Name:  caCertFlag,
+		Usage: "path to PEM encoded CA certificate to verify the host, enables TLS",
+	}
+
+	clientCert = &cli.StringFlag{
+		Name:  clientCertFlag,
+		Usage: "path to PEM encoded client certificate, enables TLS",
+	}
+
+	clientKey = &cli.StringFlag{
+		Name:  clientKeyFlag,
+		Usage: "path to PEM encoded client certificate key, enables TLS",
+	}
+
+	insecureSkipVerify = &cli.BoolFlag{
+		Name:  insecureSkipVerifyFlag,
+		Usage: "do not verify host certificate, enables TLS",
+	}
+
 	hostAddr = &cli.StringFlag{
 		Name:    hostFlag,
-		Usage:   "host net address",
+		Usage:   "host net address, grpcs:// scheme enables TLS",
 		EnvVars: []string{HostEnvValue},
 	}
 
@@ -95,6 +149,17 @@ var (
 		Usage: "storage group",
 	}
 
+	searchRoot = &cli.BoolFlag{
+		Name:  rootFlag,
+		Usage: "search only user's objects",
+	}
+
+	searchQuery = &cli.StringFlag{
+		Name:    queryFlag,
+		Aliases: []string{"q"},
+		Usage:   "search query expression, e.g. 'FileName == \"a.txt\" && Size > 1024'",
+	}
+
 	filePath = &cli.StringFlag{
 		Name:  fileFlag,
 		Usage: "path to output file",
@@ -102,7 +167,7 @@ var (
 
 	filesPath = &cli.StringSliceFlag{
 		Name:  fileFlag,
-		Usage: "path to output file",
+		Usage: "path to input file, - for standard input",
 	}
 
 	permissions = &cli.UintFlag{
@@ -116,9 +181,9 @@ var (
 		Usage: "withdrawal ID",
 	}
 
-	amount = &cli.Float64Flag{
+	amount = &cli.StringFlag{
 		Name:  amountFlag,
-		Usage: "withdrawal amount",
+		Usage: "withdrawal amount in GAS, e.g. 1.5 or 1.5GAS, or integer with fixed8 suffix, e.g. 150000000fixed8",
 	}
 
 	blockHeight = &cli.Uint64Flag{
@@ -141,20 +206,43 @@ var (
 		Usage: "extended ACL table in hex format",
 	}
 
+	eaclRules = &cli.StringFlag{
+		Name:  rulesFlag,
+		Usage: "path to extended ACL rules file in yaml or json format",
+	}
+
 	bearer = &cli.StringFlag{
 		Name:  bearerFlag,
 		Usage: "ACL rules for Bearer token in hex format",
 	}
 
+	bearerFile = &cli.StringFlag{
+		Name:  bearerFileFlag,
+		Usage: "path to signed Bearer token file",
+	}
+
+	concurrency = &cli.IntFlag{
+		Name:  concurrencyFlag,
+		Usage: "number of objects transferred in parallel",
+		Value: 1,
+	}
+
 	extHeader = &cli.StringSliceFlag{
 		Name:  extHdrFlag,
 		Usage: "provide optional request headers",
 	}
 )
 
+var (
+	keyMtx = new(sync.Mutex)
+
+	// loadedKey is a decrypted private key, it is kept only in memory.
+	loadedKey *ecdsa.PrivateKey
+)
+
 func signRequest(c *cli.Context, req service.RequestSignedData) {
 	key := getKey(c)
-	if err := service.SignRequestData(key, req); err != nil {
+	if err := signRequestData(key, req); err != nil {
 		fmt.Printf("%T could not sign request\n", req)
 		fmt.Println(err.Error())
 		os.Exit(2)
@@ -169,9 +257,9 @@ func getHost(c *cli.Context) string {
 
 	if arg := c.String(hostFlag); arg == "" {
 		fmt.Println("host cannot be empty (--host)")
-		fmt.Println("provide <host>:<port> or <ip>:<port>")
+		fmt.Println("provide [grpc://|grpcs://]<host>:<port> or <ip>:<port>")
 		os.Exit(2)
-	} else if host, err = parseHostValue(arg); err != nil {
+	} else if _, host, err = parseHost(arg); err != nil {
 		fmt.Printf("could not parse host from: %s\n", arg)
 		fmt.Println(err.Error())
 		os.Exit(2)
@@ -180,22 +268,29 @@ func getHost(c *cli.Context) string {
 	return host
 }
 
+// getKey loads private key once per run, so passphrase
+// of encrypted key is requested only once.
 func getKey(c *cli.Context) *ecdsa.PrivateKey {
-	var (
-		err error
-		key *ecdsa.PrivateKey
-	)
+	keyMtx.Lock()
+	defer keyMtx.Unlock()
+
+	if loadedKey != nil {
+		return loadedKey
+	}
+
+	var err error
 
 	if arg := c.String(keyFlag); arg == "" {
 		fmt.Println("private key cannot be empty (--key)")
-		fmt.Println("provide hex-string, wif or path")
+		fmt.Println("provide hex-string, wif, NEP-2 key or path to key file or NEP-6 wallet")
 		os.Exit(2)
-	} else if key, err = crypto.LoadPrivateKey(arg); err != nil {
+	} else if loadedKey, err = loadKey(c, arg); err != nil {
 		fmt.Printf("could not load private key: %s\n", arg)
 		fmt.Println(err.Error())
 		os.Exit(2)
 	}
-	return key
+
+	return loadedKey
 }
 
 func setTTL(c *cli.Context, req service.TTLContainer) {
@@ -4,6 +4,7 @@ go 1.14
 
 require (
 	github.com/mr-tron/base58 v1.2.0
+	github.com/nspcc-dev/neo-go v0.90.0
 	github.com/nspcc-dev/neofs-api-go v1.3.0
 	github.com/nspcc-dev/neofs-crypto v0.3.0
 	github.com/nspcc-dev/netmap v1.7.0
@@ -13,7 +14,9 @@ require (
 	github.com/spf13/viper v1.7.0
 	github.com/stretchr/testify v1.6.0
 	github.com/urfave/cli/v2 v2.2.0
+	golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550
 	google.golang.org/grpc v1.29.1
+	gopkg.in/yaml.v2 v2.2.5
 )
 
 // Temporary, before we move repo to github:
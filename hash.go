@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neofs-api-go/hash"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+)
+
+const concatFlag = "concat"
+
+var hashAction = &action{
+	Action: hashFile,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  fileFlag,
+			Usage: "path to the file to hash",
+		},
+		&cli.StringFlag{
+			Name:  saltFlag,
+			Usage: "salt to hash with in hex",
+		},
+		&cli.BoolFlag{
+			Name:  concatFlag,
+			Usage: "concatenate hashes of all ranges",
+		},
+	},
+}
+
+// hashRange calculates homomorphic hash of the data range XORed
+// with the salt the same way as nodes do. Data is read by chunks
+// of the specified size.
+func hashRange(r io.ReaderAt, rng object.Range, salt []byte, size int) (hash.Hash, error) {
+	var (
+		h  = hash.Sum(nil)
+		sr = io.NewSectionReader(r, int64(rng.Offset), int64(rng.Length))
+	)
+
+	// salt is applied from the beginning of the range,
+	// so every chunk must start at the beginning of the salt
+	if len(salt) > 0 {
+		size -= size % len(salt)
+	}
+
+	data := make([]byte, size)
+
+	for {
+		n, err := io.ReadFull(sr, data)
+		if n > 0 {
+			if h, err = hash.Concat([]hash.Hash{h, hash.Sum(hash.SaltXOR(data[:n], salt))}); err != nil {
+				return h, err
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return h, nil
+		} else if err != nil {
+			return h, err
+		}
+	}
+}
+
+func hashFile(c *cli.Context) error {
+	var (
+		err    error
+		salt   []byte
+		ranges []object.Range
+
+		fPath   = c.String(fileFlag)
+		saltArg = c.String(saltFlag)
+	)
+
+	if fPath == "" {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if salt, err = hex.DecodeString(saltArg); err != nil {
+		return errors.Wrap(err, "can't decode salt")
+	} else if ranges, err = parseRanges(c.Args()); err != nil {
+		return errors.Wrap(err, "can't parse ranges")
+	}
+
+	fd, err := os.Open(fPath)
+	if err != nil {
+		return errors.Wrapf(err, "can't open file %s", fPath)
+	}
+	defer fd.Close()
+
+	fi, err := fd.Stat()
+	if err != nil {
+		return errors.Wrap(err, "can't get file info")
+	}
+
+	size := uint64(fi.Size())
+
+	if len(ranges) == 0 {
+		ranges = []object.Range{{Offset: 0, Length: size}}
+	}
+
+	var (
+		out    = hashOutput{Ranges: make([]rangeHashOutput, 0, len(ranges))}
+		hashes = make([]hash.Hash, 0, len(ranges))
+	)
+
+	for _, rng := range ranges {
+		if rng.Offset+rng.Length > size {
+			return errors.Errorf("range %d:%d is out of file size %d", rng.Offset, rng.Length, size)
+		}
+
+		h, err := hashRange(fd, rng, salt, int(dataChunkSize))
+		if err != nil {
+			return errors.Wrapf(err, "can't hash range %d:%d", rng.Offset, rng.Length)
+		}
+
+		hashes = append(hashes, h)
+		out.Ranges = append(out.Ranges, rangeHashOutput{
+			Offset: rng.Offset,
+			Length: rng.Length,
+			Hash:   h.String(),
+		})
+	}
+
+	if c.Bool(concatFlag) {
+		h, err := hash.Concat(hashes)
+		if err != nil {
+			return errors.Wrap(err, "can't concatenate hashes")
+		}
+
+		out.Concat = h.String()
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		for _, rng := range out.Ranges {
+			if _, err := fmt.Fprintf(w, "%d:%d %s\n", rng.Offset, rng.Length, rng.Hash); err != nil {
+				return err
+			}
+		}
+
+		if out.Concat != "" {
+			_, err := fmt.Fprintf(w, "Concatenation: %s\n", out.Concat)
+			return err
+		}
+
+		return nil
+	})
+}
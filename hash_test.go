@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/hash"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hashRange(t *testing.T) {
+	var (
+		data = make([]byte, 1000)
+		salt = []byte{1, 2, 3, 4, 5}
+	)
+
+	rand.Read(data)
+
+	r := bytes.NewReader(data)
+
+	t.Run("several chunks with salt", func(t *testing.T) {
+		rng := object.Range{Offset: 10, Length: uint64(len(data) - 20)}
+
+		h, err := hashRange(r, rng, salt, 64)
+		require.NoError(t, err)
+		require.Equal(t, hash.Sum(hash.SaltXOR(data[10:len(data)-10], salt)), h)
+	})
+
+	t.Run("concatenation", func(t *testing.T) {
+		first, err := hashRange(r, object.Range{Offset: 0, Length: 100}, nil, 64)
+		require.NoError(t, err)
+
+		second, err := hashRange(r, object.Range{Offset: 100, Length: 50}, nil, 64)
+		require.NoError(t, err)
+
+		whole, err := hashRange(r, object.Range{Offset: 0, Length: 150}, nil, 64)
+		require.NoError(t, err)
+
+		cat, err := hash.Concat([]hash.Hash{first, second})
+		require.NoError(t, err)
+		require.Equal(t, whole, cat)
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		h, err := hashRange(r, object.Range{Offset: 10}, salt, 64)
+		require.NoError(t, err)
+		require.Equal(t, hash.Sum(nil), h)
+	})
+}
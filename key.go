@@ -0,0 +1,195 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/chain"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	keyFormatHex  = "hex"
+	keyFormatWIF  = "wif"
+	keyFormatRaw  = "raw"
+	keyFormatNEP2 = "nep2"
+
+	// privateKeySize is a size of binary encoded private key.
+	privateKeySize = 32
+
+	// keyPermission is a permission of created key files.
+	keyPermission = 0600
+)
+
+var (
+	keyAction = &action{}
+
+	generateKeyAction = &action{
+		Action: generateKey,
+		Flags: []cli.Flag{
+			keyFormat,
+			keyOutput,
+		},
+	}
+
+	infoKeyAction = &action{
+		Action: infoKey,
+	}
+
+	convertKeyAction = &action{
+		Action: convertKey,
+		Flags: []cli.Flag{
+			keyFormat,
+			keyOutput,
+		},
+	}
+
+	keyFormat = &cli.StringFlag{
+		Name:  formatFlag,
+		Usage: "key format: hex, wif, raw or nep2",
+		Value: keyFormatHex,
+	}
+
+	keyOutput = &cli.StringFlag{
+		Name:  fileFlag,
+		Usage: "path to output key file, key is printed if not set",
+	}
+)
+
+// encodeKey returns private key in the specified format.
+func encodeKey(key *ecdsa.PrivateKey, format string) ([]byte, error) {
+	// big.Int drops leading zeros, but binary key must have fixed size
+	raw := make([]byte, privateKeySize)
+	d := crypto.MarshalPrivateKey(key)
+	copy(raw[privateKeySize-len(d):], d)
+
+	switch format {
+	case keyFormatRaw:
+		return raw, nil
+	case keyFormatHex:
+		return []byte(hex.EncodeToString(raw)), nil
+	case keyFormatWIF:
+		return []byte(wifEncode(raw)), nil
+	default:
+		return nil, errors.Errorf("unknown key format: %q", format)
+	}
+}
+
+// wifEncode encodes binary private key into WIF string.
+// crypto.WIFEncode is not used because it misplaces keys with leading zeros.
+func wifEncode(raw []byte) string {
+	data := make([]byte, 0, crypto.WIFLength)
+	data = append(data, 0x80)
+	data = append(data, raw...)
+	data = append(data, 0x01)
+
+	sum := sha256.Sum256(data)
+	sum = sha256.Sum256(sum[:])
+
+	return base58.Encode(append(data, sum[:4]...))
+}
+
+// writeKey saves key into the new file with strict permissions
+// or prints it if file is not set.
+func writeKey(c *cli.Context, key *ecdsa.PrivateKey, format, fPath string) error {
+	var (
+		err  error
+		data []byte
+	)
+
+	if format == keyFormatNEP2 {
+		pass, err := readNewPassphrase(c)
+		if err != nil {
+			return err
+		}
+
+		enc, err := encryptNEP2(key, pass)
+		if err != nil {
+			return errors.Wrap(err, "could not encrypt key")
+		}
+
+		data = []byte(enc)
+	} else if data, err = encodeKey(key, format); err != nil {
+		return err
+	}
+
+	if fPath == "" {
+		if format == keyFormatRaw {
+			return errors.New("raw key can be written only to file")
+		}
+
+		fmt.Println(string(data))
+
+		return nil
+	}
+
+	f, err := os.OpenFile(fPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, keyPermission)
+	if err != nil {
+		return errors.Wrapf(err, "could not create key file %s", fPath)
+	}
+
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "could not write key file %s", fPath)
+	} else if err = f.Close(); err != nil {
+		return errors.Wrapf(err, "could not write key file %s", fPath)
+	}
+
+	out := keyFileOutput{Format: format, File: fPath}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "%s key saved to %s\n", out.Format, out.File)
+		return err
+	})
+}
+
+func keyOutputFrom(key *ecdsa.PublicKey) (*keyInfoOutput, error) {
+	owner, err := refs.NewOwnerID(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute owner ID")
+	}
+
+	return &keyInfoOutput{
+		PublicKey: hex.EncodeToString(crypto.MarshalPublicKey(key)),
+		Address:   chain.KeyToAddress(key),
+		OwnerID:   owner.String(),
+		OwnerHex:  hex.EncodeToString(owner.Bytes()),
+	}, nil
+}
+
+func generateKey(c *cli.Context) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return errors.Wrap(err, "could not generate key")
+	}
+
+	return writeKey(c, key, c.String(formatFlag), c.String(fileFlag))
+}
+
+func infoKey(c *cli.Context) error {
+	out, err := keyOutputFrom(&getKey(c).PublicKey)
+	if err != nil {
+		return err
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Public key: %s\nAddress: %s\nOwner ID: %s (%s)\n",
+			out.PublicKey, out.Address, out.OwnerID, out.OwnerHex)
+
+		return err
+	})
+}
+
+func convertKey(c *cli.Context) error {
+	return writeKey(c, getKey(c), c.String(formatFlag), c.String(fileFlag))
+}
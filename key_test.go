@@ -0,0 +1,115 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func Test_encodeKey(t *testing.T) {
+	// binary key with leading zeros
+	buf := make([]byte, privateKeySize)
+	copy(buf[privateKeySize-3:], []byte{1, 2, 3})
+
+	small, err := crypto.UnmarshalPrivateKey(buf)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "key")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	for _, key := range []*ecdsa.PrivateKey{test.DecodeKey(0), small} {
+		for _, format := range []string{keyFormatHex, keyFormatWIF, keyFormatRaw} {
+			data, err := encodeKey(key, format)
+			require.NoError(t, err, format)
+
+			val := string(data)
+
+			if format == keyFormatRaw {
+				require.Len(t, data, privateKeySize)
+
+				val = filepath.Join(dir, "raw")
+				require.NoError(t, ioutil.WriteFile(val, data, keyPermission))
+			}
+
+			res, err := crypto.LoadPrivateKey(val)
+			require.NoError(t, err, format)
+			require.Equal(t, key.D, res.D, format)
+		}
+	}
+
+	_, err = encodeKey(test.DecodeKey(0), "pem")
+	require.Error(t, err)
+}
+
+func Test_writeKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "key")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	key := test.DecodeKey(0)
+	fPath := filepath.Join(dir, "key")
+
+	require.NoError(t, writeKey(nil, key, keyFormatHex, fPath))
+
+	info, err := os.Stat(fPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(keyPermission), info.Mode().Perm())
+
+	data, err := ioutil.ReadFile(fPath)
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(crypto.MarshalPrivateKey(key)), string(data))
+
+	// existing file is not overwritten
+	require.Error(t, writeKey(nil, key, keyFormatHex, fPath))
+
+	// raw key is not printed
+	require.Error(t, writeKey(nil, key, keyFormatRaw, ""))
+
+	t.Run("json output", func(t *testing.T) {
+		var (
+			c     = newTestContext(t, []cli.Flag{outputFormat}, "--output", outputJSON)
+			fPath = filepath.Join(dir, "wif")
+		)
+
+		res := captureStdout(t, func() {
+			require.NoError(t, writeKey(c, key, keyFormatWIF, fPath))
+		})
+		require.JSONEq(t, `{"format": "wif", "file": "`+fPath+`"}`, res)
+	})
+}
+
+func Test_keyOutputFrom(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	owner, err := refs.NewOwnerID(&key.PublicKey)
+	require.NoError(t, err)
+
+	out, err := keyOutputFrom(&key.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, owner.String(), out.OwnerID)
+	require.Equal(t, owner.String(), out.Address)
+	require.Equal(t, hex.EncodeToString(crypto.MarshalPublicKey(&key.PublicKey)), out.PublicKey)
+}
+
+func Test_wifEncode(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	expected, err := crypto.WIFEncode(key)
+	require.NoError(t, err)
+
+	data, err := encodeKey(key, keyFormatWIF)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(data))
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	addressFlag      = "address"
+	passphraseFDFlag = "passphrase-fd"
+	plainKeyFlag     = "plain-key"
+
+	// nep2Prefix and nep2Length describe base58 encoded NEP-2 key.
+	nep2Prefix = "6P"
+	nep2Length = 58
+)
+
+type (
+	// nep6Wallet is a part of NEP-6 wallet file required to load keys.
+	nep6Wallet struct {
+		Accounts []nep6Account `json:"accounts"`
+	}
+
+	nep6Account struct {
+		Address string `json:"address"`
+		Key     string `json:"key"`
+		Label   string `json:"label"`
+		Default bool   `json:"isDefault"`
+	}
+)
+
+// passphraseReader reads passphrases line by line from --passphrase-fd.
+var passphraseReader *bufio.Reader
+
+func isNEP2(val string) bool {
+	return len(val) == nep2Length && strings.HasPrefix(val, nep2Prefix)
+}
+
+// isEncryptedKey checks if key value is NEP-2 key or path to file.
+// Plain keys from files are not stored in config, only their paths.
+func isEncryptedKey(val string) bool {
+	if isNEP2(val) {
+		return true
+	}
+
+	_, err := os.Stat(val)
+
+	return err == nil
+}
+
+// checkConfigKey checks that key value can be stored in config. Plain hex
+// and WIF keys are refused unless --plain-key is set.
+func checkConfigKey(c *cli.Context, val string) error {
+	if _, err := loadKey(c, val); err != nil {
+		return err
+	}
+
+	if !isEncryptedKey(val) && !c.Bool(plainKeyFlag) {
+		return errors.Errorf("plain private key can't be stored in config, use NEP-2 key, "+
+			"path to key file or NEP-6 wallet, or set --%s", plainKeyFlag)
+	}
+
+	return nil
+}
+
+// loadKey loads private key from hex or WIF string, NEP-2 encrypted key,
+// binary key file, file with NEP-2 key or NEP-6 wallet. Passphrase of
+// encrypted keys is requested from the terminal or read from --passphrase-fd.
+func loadKey(c *cli.Context, val string) (*ecdsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(val)
+
+	switch {
+	case err == nil && bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")):
+		return loadWalletKey(c, data)
+	case err == nil && isNEP2(string(bytes.TrimSpace(data))):
+		return decryptNEP2(c, string(bytes.TrimSpace(data)), val)
+	case err != nil && isNEP2(val):
+		return decryptNEP2(c, val, "NEP-2 key")
+	default:
+		return crypto.LoadPrivateKey(val)
+	}
+}
+
+func loadWalletKey(c *cli.Context, data []byte) (*ecdsa.PrivateKey, error) {
+	w := new(nep6Wallet)
+	if err := json.Unmarshal(data, w); err != nil {
+		return nil, errors.Wrap(err, "could not parse NEP-6 wallet")
+	}
+
+	acc, err := selectAccount(w.Accounts, c.String(addressFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptNEP2(c, acc.Key, acc.Address)
+}
+
+// selectAccount returns wallet account with the address. If address is not
+// set, the only account or the default one is returned.
+func selectAccount(accounts []nep6Account, addr string) (*nep6Account, error) {
+	if addr != "" {
+		for i := range accounts {
+			if accounts[i].Address == addr {
+				return &accounts[i], nil
+			}
+		}
+
+		return nil, errors.Errorf("account %s not found in wallet", addr)
+	}
+
+	switch len(accounts) {
+	case 0:
+		return nil, errors.New("wallet has no accounts")
+	case 1:
+		return &accounts[0], nil
+	}
+
+	for i := range accounts {
+		if accounts[i].Default {
+			return &accounts[i], nil
+		}
+	}
+
+	return nil, errors.Errorf("wallet has %d accounts, select one with --%s", len(accounts), addressFlag)
+}
+
+func decryptNEP2(c *cli.Context, enc, name string) (*ecdsa.PrivateKey, error) {
+	pass, err := readPassphrase(c, fmt.Sprintf("Enter passphrase for %s: ", name))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keys.NEP2Decrypt(enc, pass)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt %s", name)
+	}
+
+	return crypto.UnmarshalPrivateKey(key.Bytes())
+}
+
+// encryptNEP2 encrypts private key with passphrase into NEP-2 string.
+func encryptNEP2(key *ecdsa.PrivateKey, pass string) (string, error) {
+	raw, err := encodeKey(key, keyFormatRaw)
+	if err != nil {
+		return "", err
+	}
+
+	priv, err := keys.NewPrivateKeyFromBytes(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return keys.NEP2Encrypt(priv, pass)
+}
+
+// readPassphrase reads line from --passphrase-fd descriptor
+// or requests passphrase in the terminal.
+func readPassphrase(c *cli.Context, prompt string) (string, error) {
+	if c.IsSet(passphraseFDFlag) {
+		if passphraseReader == nil {
+			passphraseReader = bufio.NewReader(os.NewFile(uintptr(c.Int(passphraseFDFlag)), "passphrase"))
+		}
+
+		line, err := passphraseReader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return "", errors.Wrap(err, "could not read passphrase")
+		}
+
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return "", errors.Errorf("passphrase is required, run in terminal or use --%s", passphraseFDFlag)
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+
+	pass, err := terminal.ReadPassword(fd)
+
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		return "", errors.Wrap(err, "could not read passphrase")
+	}
+
+	return string(pass), nil
+}
+
+// readNewPassphrase requests new passphrase twice in the terminal
+// to exclude typos.
+func readNewPassphrase(c *cli.Context) (string, error) {
+	pass, err := readPassphrase(c, "Enter new passphrase: ")
+	if err != nil || c.IsSet(passphraseFDFlag) {
+		return pass, err
+	}
+
+	confirm, err := readPassphrase(c, "Repeat passphrase: ")
+	if err != nil {
+		return "", err
+	} else if pass != confirm {
+		return "", errors.New("passphrases do not match")
+	}
+
+	return pass, nil
+}
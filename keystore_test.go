@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func Test_encryptNEP2(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	enc, err := encryptNEP2(key, "secret")
+	require.NoError(t, err)
+	require.True(t, isNEP2(enc))
+	require.True(t, isEncryptedKey(enc))
+
+	priv, err := keys.NEP2Decrypt(enc, "secret")
+	require.NoError(t, err)
+
+	raw, err := encodeKey(key, keyFormatRaw)
+	require.NoError(t, err)
+	require.Equal(t, raw, priv.Bytes())
+
+	_, err = keys.NEP2Decrypt(enc, "wrong")
+	require.Error(t, err)
+}
+
+func Test_selectAccount(t *testing.T) {
+	accounts := []nep6Account{{Address: "a"}, {Address: "b", Default: true}, {Address: "c"}}
+
+	acc, err := selectAccount(accounts, "c")
+	require.NoError(t, err)
+	require.Equal(t, "c", acc.Address)
+
+	acc, err = selectAccount(accounts, "")
+	require.NoError(t, err)
+	require.Equal(t, "b", acc.Address)
+
+	acc, err = selectAccount(accounts[:1], "")
+	require.NoError(t, err)
+	require.Equal(t, "a", acc.Address)
+
+	_, err = selectAccount(accounts, "d")
+	require.Error(t, err)
+
+	_, err = selectAccount([]nep6Account{{Address: "a"}, {Address: "c"}}, "")
+	require.Error(t, err)
+
+	_, err = selectAccount(nil, "")
+	require.Error(t, err)
+}
+
+func Test_loadKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	// passphrases are written into pipe one per line
+	newContext := func(t *testing.T, passphrases string, args ...string) *cli.Context {
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+
+		_, err = w.WriteString(passphrases)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		passphraseReader = nil
+
+		return newTestContext(t, []cli.Flag{walletAddress, passphraseFD},
+			append(args, "--passphrase-fd", strconv.Itoa(int(r.Fd())))...)
+	}
+
+	first, second := test.DecodeKey(0), test.DecodeKey(1)
+
+	encFirst, err := encryptNEP2(first, "one")
+	require.NoError(t, err)
+
+	encSecond, err := encryptNEP2(second, "two")
+	require.NoError(t, err)
+
+	t.Run("NEP-2 key", func(t *testing.T) {
+		key, err := loadKey(newContext(t, "one\n"), encFirst)
+		require.NoError(t, err)
+		require.Equal(t, first, key)
+
+		_, err = loadKey(newContext(t, "two\n"), encFirst)
+		require.Error(t, err)
+	})
+
+	t.Run("NEP-2 key file", func(t *testing.T) {
+		fPath := filepath.Join(dir, "key.nep2")
+		require.NoError(t, ioutil.WriteFile(fPath, []byte(encSecond+"\n"), keyPermission))
+
+		key, err := loadKey(newContext(t, "two"), fPath)
+		require.NoError(t, err)
+		require.Equal(t, second, key)
+	})
+
+	t.Run("NEP-6 wallet", func(t *testing.T) {
+		data, err := json.Marshal(nep6Wallet{Accounts: []nep6Account{
+			{Address: "first", Key: encFirst},
+			{Address: "second", Key: encSecond},
+		}})
+		require.NoError(t, err)
+
+		fPath := filepath.Join(dir, "wallet.json")
+		require.NoError(t, ioutil.WriteFile(fPath, data, keyPermission))
+
+		key, err := loadKey(newContext(t, "two\n", "--address", "second"), fPath)
+		require.NoError(t, err)
+		require.Equal(t, second, key)
+
+		// account must be selected
+		_, err = loadKey(newContext(t, "one\n"), fPath)
+		require.Error(t, err)
+	})
+
+	t.Run("missing passphrase", func(t *testing.T) {
+		_, err := loadKey(newContext(t, ""), encFirst)
+		require.Error(t, err)
+	})
+}
+
+func Test_checkConfigKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	newContext := func(t *testing.T, passphrases string, args ...string) *cli.Context {
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+
+		_, err = w.WriteString(passphrases)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		passphraseReader = nil
+
+		return newTestContext(t, []cli.Flag{walletAddress, passphraseFD, plainKey},
+			append(args, "--passphrase-fd", strconv.Itoa(int(r.Fd())))...)
+	}
+
+	key := test.DecodeKey(0)
+	plain := fmt.Sprintf("%064x", key.D)
+
+	t.Run("plain key", func(t *testing.T) {
+		err := checkConfigKey(newContext(t, ""), plain)
+		require.EqualError(t, err, "plain private key can't be stored in config, use NEP-2 key, "+
+			"path to key file or NEP-6 wallet, or set --plain-key")
+
+		require.NoError(t, checkConfigKey(newContext(t, "", "--plain-key"), plain))
+	})
+
+	t.Run("NEP-2 key file", func(t *testing.T) {
+		enc, err := encryptNEP2(key, "one")
+		require.NoError(t, err)
+
+		fPath := filepath.Join(dir, "key.nep2")
+		require.NoError(t, ioutil.WriteFile(fPath, []byte(enc), keyPermission))
+
+		require.NoError(t, checkConfigKey(newContext(t, "one\n"), fPath))
+		require.NoError(t, checkConfigKey(newContext(t, "one\n"), enc))
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		require.Error(t, checkConfigKey(newContext(t, "", "--plain-key"), "not a key"))
+	})
+}
@@ -38,6 +38,12 @@ func main() {
 			}
 
 			os.Exit(2)
+		} else if cause := errors.Cause(err); cause == errPayloadVerification || cause == errChequeVerification {
+			fmt.Println(err)
+			os.Exit(verificationExitCode)
+		} else if errors.Cause(err) == errNotConfirmed {
+			fmt.Println(err)
+			os.Exit(notConfirmedExitCode)
 		} else if _, ok := err.(cli.ExitCoder); !ok {
 			fmt.Println(err)
 			os.Exit(2)
@@ -5,11 +5,15 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"text/tabwriter"
 
 	"github.com/nspcc-dev/neofs-api-go/hash"
 	"github.com/nspcc-dev/neofs-api-go/object"
@@ -34,6 +38,8 @@ type (
 		cmd *cli.Context
 
 		conn *grpc.ClientConn
+
+		tokens *tokenCache
 	}
 
 	sessionParams struct {
@@ -54,6 +60,14 @@ type (
 
 		verb service.Token_Info_Verb
 	}
+
+	putParams struct {
+		connectionParams
+
+		cid refs.CID
+
+		owner refs.OwnerID
+	}
 )
 
 const (
@@ -64,8 +78,26 @@ const (
 	userHeaderFlag  = "user"
 	rawFlag         = "raw"
 	copiesNumFlag   = "copies"
+	dirFlag         = "dir"
+	includeFlag     = "include"
+	excludeFlag     = "exclude"
+	concurrencyFlag = "concurrency"
+	resumeFlag      = "resume"
+	queryFlag       = "query"
+	maxSizeFlag     = "max-object-size"
+
+	// stdPath is a file path value that refers to standard input or output.
+	stdPath = "-"
+
+	filePathHeader  = "FilePath"
+	fileNameHeader  = "FileName"
+	fileSizeHeader  = "FileSize"
+	fileMTimeHeader = "FileMTime"
 
 	dataChunkSize = 3 * object.UnitsMB
+
+	// defaultMaxObjectSize is a default maximum object payload size in MB.
+	defaultMaxObjectSize = 64
 )
 
 var (
@@ -88,17 +120,53 @@ var (
 				Name:  copiesNumFlag,
 				Usage: "set number of copies to store",
 			},
+			&cli.StringFlag{
+				Name:  dirFlag,
+				Usage: "path to directory to upload recursively",
+			},
+			&cli.StringSliceFlag{
+				Name:  includeFlag,
+				Usage: "glob pattern of files to upload from directory",
+			},
+			&cli.StringSliceFlag{
+				Name:  excludeFlag,
+				Usage: "glob pattern of files to skip in directory",
+			},
+			&cli.Uint64Flag{
+				Name:  maxSizeFlag,
+				Usage: "maximum object payload size in MB, larger files are split into linked objects",
+				Value: defaultMaxObjectSize,
+			},
+			concurrency,
 			bearer,
+			bearerFile,
 		},
 	}
 	getObjectAction = &action{
 		Action: get,
 		Flags: []cli.Flag{
 			containerID,
-			objectID,
-			filePath,
+			objectIDs,
+			&cli.StringFlag{
+				Name:  fileFlag,
+				Usage: "path to output file, - for standard output",
+			},
 			permissions,
+			&cli.StringFlag{
+				Name:  dirFlag,
+				Usage: "path to output directory to get several objects",
+			},
+			&cli.BoolFlag{
+				Name:  resumeFlag,
+				Usage: "continue interrupted download of existing partial file",
+			},
+			&cli.BoolFlag{
+				Name:  noVerifyFlag,
+				Usage: "do not verify received payload and headers",
+			},
+			concurrency,
 			bearer,
+			bearerFile,
 		},
 	}
 	delObjectAction = &action{
@@ -107,6 +175,7 @@ var (
 			containerID,
 			objectID,
 			bearer,
+			bearerFile,
 		},
 	}
 	headObjectAction = &action{
@@ -116,6 +185,7 @@ var (
 			objectID,
 			fullHeaders,
 			bearer,
+			bearerFile,
 		},
 	}
 	searchObjectAction = &action{
@@ -123,11 +193,10 @@ var (
 		Flags: []cli.Flag{
 			containerID,
 			storageGroup,
-			&cli.BoolFlag{
-				Name:  rootFlag,
-				Usage: "search only user's objects",
-			},
+			searchRoot,
+			searchQuery,
 			bearer,
+			bearerFile,
 		},
 	}
 	getRangeObjectAction = &action{
@@ -135,7 +204,17 @@ var (
 		Flags: []cli.Flag{
 			containerID,
 			objectID,
+			&cli.StringFlag{
+				Name:  fileFlag,
+				Usage: "path to output file, - for standard output",
+			},
+			&cli.StringFlag{
+				Name:  formatFlag,
+				Usage: "output format: raw, hex or base64, hex for standard output and raw for file by default",
+			},
+			permissions,
 			bearer,
+			bearerFile,
 		},
 	}
 	getRangeHashObjectAction = &action{
@@ -154,21 +233,31 @@ var (
 			filePath,
 			permissions,
 			bearer,
+			bearerFile,
 		},
 	}
 )
 
 func addBearerToken(c *cli.Context, req *service.RequestVerificationHeader) error {
-	sBearer := c.String(bearerFlag)
-	if sBearer == "" {
-		return nil
-	}
+	var (
+		sBearer = c.String(bearerFlag)
+		fBearer = c.String(bearerFileFlag)
+	)
 
-	key := getKey(c)
+	switch {
+	case sBearer != "" && fBearer != "":
+		return errors.New("--bearer and --bearer-file can't be used together")
+	case fBearer != "":
+		bearer, err := readBearerToken(fBearer)
+		if err != nil {
+			return err
+		}
 
-	owner, err := refs.NewOwnerID(&key.PublicKey)
-	if err != nil {
-		return errors.Wrap(err, "could not compute owner ID")
+		req.SetBearer(bearer)
+
+		return nil
+	case sBearer == "":
+		return nil
 	}
 
 	bearerRules, err := hex.DecodeString(sBearer)
@@ -176,14 +265,14 @@ func addBearerToken(c *cli.Context, req *service.RequestVerificationHeader) erro
 		return errors.Wrap(err, "could not decode bearer ACL rules")
 	}
 
-	bearer := new(service.BearerTokenMsg)
-	bearer.SetExpirationEpoch(math.MaxUint64)
-	bearer.SetACLRules(bearerRules)
-	bearer.SetOwnerID(owner)
+	bearer, err := newBearerToken(getKey(c), bearerRules, math.MaxUint64)
+	if err != nil {
+		return err
+	}
 
 	req.SetBearer(bearer)
 
-	return service.AddSignatureWithKey(key, service.NewSignedBearerToken(bearer))
+	return nil
 }
 
 func del(c *cli.Context) error {
@@ -295,29 +384,43 @@ func head(c *cli.Context) error {
 		CID:      cid,
 	}
 
+	obj, err := headObject(connectionParams{
+		ctx:  ctx,
+		cmd:  c,
+		conn: conn,
+	}, addr, fh)
+	if err != nil {
+		return err
+	}
+
+	return printOutput(c, objectOutputFrom(obj), func(w io.Writer) error {
+		return objectStringify(w, obj)
+	})
+}
+
+// headObject receives object header.
+func headObject(p connectionParams, addr refs.Address, fullHeaders bool) (*object.Object, error) {
+	c := p.cmd
+
 	token, err := createToken(tokenParams{
-		connectionParams: connectionParams{
-			ctx:  ctx,
-			cmd:  c,
-			conn: conn,
-		},
+		connectionParams: p,
 
 		addr: addr,
 
 		verb: service.Token_Info_Head,
 	})
 	if err != nil {
-		return errors.Wrap(err, "could not create session token")
+		return nil, errors.Wrap(err, "could not create session token")
 	}
 
 	req := &object.HeadRequest{
 		Address:     addr,
-		FullHeaders: fh,
+		FullHeaders: fullHeaders,
 	}
 	req.SetToken(token)
 
 	if err := addBearerToken(c, &req.RequestVerificationHeader); err != nil {
-		return errors.Wrap(err, "could not attach Bearer token")
+		return nil, errors.Wrap(err, "could not attach Bearer token")
 	}
 
 	req.SetHeaders(parseRequestHeaders(c.StringSlice(extHdrFlag)))
@@ -325,12 +428,12 @@ func head(c *cli.Context) error {
 	setRaw(c, req)
 	signRequest(c, req)
 
-	resp, err := object.NewServiceClient(conn).Head(ctx, req)
+	resp, err := object.NewServiceClient(p.conn).Head(p.ctx, req)
 	if err != nil {
-		return errors.Wrap(err, "can't perform HEAD request")
+		return nil, errors.Wrap(err, "can't perform HEAD request")
 	}
 
-	return objectStringify(os.Stdout, resp.Object)
+	return resp.Object, nil
 }
 
 // objectStringify converts object into string format.
@@ -454,63 +557,114 @@ func objectStringify(dst io.Writer, obj *object.Object) error {
 
 func search(c *cli.Context) error {
 	var (
-		err    error
-		conn   *grpc.ClientConn
-		cid    refs.CID
-		q      query.Query
-		result []refs.Address
+		err     error
+		conn    *grpc.ClientConn
+		cid     refs.CID
+		filters []query.Filter
+		result  []refs.Address
 
 		host   = getHost(c)
 		cidArg = c.String(cidFlag)
-		qArgs  = c.Args()
-		isRoot = c.Bool(rootFlag)
-		sg     = c.Bool(sgFlag)
 		ctx    = gracefulContext()
 	)
 
 	if cidArg == "" {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
-	} else if c.NArg()%2 != 0 {
-		return errors.Errorf("number of positional arguments must be event\nUsage: %s", c.Command.UsageText)
+	} else if filters, err = searchFilters(c); err != nil {
+		return err
 	}
 
 	if cid, err = refs.CIDFromString(cidArg); err != nil {
 		return errors.Wrapf(err, "can't parse CID '%s'", cidArg)
-	} else if conn, err = connect(ctx, c); err != nil {
+	}
+
+	if conn, err = connect(ctx, c); err != nil {
 		return errors.Wrapf(err, "can't connect to host '%s'", host)
 	}
 
+	result, err = searchObjects(connectionParams{
+		ctx:  ctx,
+		cmd:  c,
+		conn: conn,
+	}, cid, filters)
+	if err != nil {
+		return err
+	}
+
+	return printOutput(c, searchOutputFrom(result), func(w io.Writer) error {
+		if _, err := fmt.Fprintln(w, "Container ID: Object ID"); err != nil {
+			return err
+		}
+
+		for i := range result {
+			if _, err := fmt.Fprintln(w, result[i].CID.String()+": "+result[i].ObjectID.String()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// searchFilters builds search query filters from positional key/value
+// arguments, query expression and flags of the command.
+func searchFilters(c *cli.Context) ([]query.Filter, error) {
+	var (
+		err     error
+		filters []query.Filter
+
+		qArgs = c.Args()
+		qExpr = c.String(queryFlag)
+	)
+
+	if c.NArg()%2 != 0 {
+		return nil, errors.Errorf("number of positional arguments must be event\nUsage: %s", c.Command.UsageText)
+	}
+
+	if qExpr != "" {
+		if filters, err = parseSearchQuery(qExpr); err != nil {
+			return nil, errors.Wrap(err, "can't parse search query")
+		}
+	}
+
 	for i := 0; i < qArgs.Len(); i += 2 {
-		q.Filters = append(q.Filters, query.Filter{
+		filters = append(filters, query.Filter{
 			Type:  query.Filter_Regex,
 			Name:  qArgs.Get(i),
 			Value: qArgs.Get(i + 1),
 		})
 	}
-	if isRoot {
-		q.Filters = append(q.Filters, query.Filter{
+	if c.Bool(rootFlag) {
+		filters = append(filters, query.Filter{
 			Type: query.Filter_Exact,
 			Name: object.KeyRootObject,
 		})
 	}
-	if sg {
-		q.Filters = append(q.Filters, query.Filter{
+	if c.Bool(sgFlag) {
+		filters = append(filters, query.Filter{
 			Type: query.Filter_Exact,
 			Name: object.KeyStorageGroup,
 		})
 	}
 
+	return filters, nil
+}
+
+// searchObjects returns addresses of the container objects that match filters.
+func searchObjects(p connectionParams, cid refs.CID, filters []query.Filter) ([]refs.Address, error) {
+	var (
+		c      = p.cmd
+		q      = query.Query{Filters: filters}
+		result []refs.Address
+	)
+
 	data, err := q.Marshal()
 	if err != nil {
-		return errors.Wrap(err, "can't marshal query")
+		return nil, errors.Wrap(err, "can't marshal query")
 	}
 
 	token, err := createToken(tokenParams{
-		connectionParams: connectionParams{
-			ctx:  ctx,
-			cmd:  c,
-			conn: conn,
-		},
+		connectionParams: p,
 
 		addr: refs.Address{
 			CID: cid,
@@ -519,7 +673,7 @@ func search(c *cli.Context) error {
 		verb: service.Token_Info_Search,
 	})
 	if err != nil {
-		return errors.Wrap(err, "could not create session token")
+		return nil, errors.Wrap(err, "could not create session token")
 	}
 
 	req := &object.SearchRequest{
@@ -530,7 +684,7 @@ func search(c *cli.Context) error {
 	req.SetToken(token)
 
 	if err := addBearerToken(c, &req.RequestVerificationHeader); err != nil {
-		return errors.Wrap(err, "could not attach Bearer token")
+		return nil, errors.Wrap(err, "could not attach Bearer token")
 	}
 
 	req.SetHeaders(parseRequestHeaders(c.StringSlice(extHdrFlag)))
@@ -538,9 +692,9 @@ func search(c *cli.Context) error {
 	setRaw(c, req)
 	signRequest(c, req)
 
-	searchClient, err := object.NewServiceClient(conn).Search(ctx, req)
+	searchClient, err := object.NewServiceClient(p.conn).Search(p.ctx, req)
 	if err != nil {
-		return errors.Wrap(err, "search command failed on client creation")
+		return nil, errors.Wrap(err, "search command failed on client creation")
 	}
 
 	for {
@@ -549,17 +703,12 @@ func search(c *cli.Context) error {
 			if err == io.EOF {
 				break
 			}
-			return errors.Wrap(err, "search command received error")
+			return nil, errors.Wrap(err, "search command received error")
 		}
 		result = append(result, resp.Addresses...)
 	}
 
-	fmt.Println("Container ID: Object ID")
-	for i := range result {
-		fmt.Println(result[i].CID.String() + ": " + result[i].ObjectID.String())
-	}
-
-	return nil
+	return result, nil
 }
 
 func getRange(c *cli.Context) error {
@@ -568,49 +717,121 @@ func getRange(c *cli.Context) error {
 		conn   *grpc.ClientConn
 		cid    refs.CID
 		objID  refs.ObjectID
+		specs  []rangeSpec
 		ranges []object.Range
+		size   uint64
+
+		w = io.Writer(os.Stdout)
 
 		host   = getHost(c)
 		cidArg = c.String(cidFlag)
 		objArg = c.String(objFlag)
-		rngArg = c.Args()
+		fPath  = c.String(fileFlag)
+		format = c.String(formatFlag)
 		ctx    = gracefulContext()
 	)
 
-	if cidArg == "" || objArg == "" {
+	if cidArg == "" || objArg == "" || c.NArg() == 0 {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
 	}
 
-	if cid, err = refs.CIDFromString(cidArg); err != nil {
+	if format == "" {
+		// data is written into the file as is
+		if format = rangeFormatHex; fPath != "" && fPath != stdPath {
+			format = rangeFormatRaw
+		}
+	}
+
+	if _, _, err = rangeWriter(w, format); err != nil {
+		return err
+	} else if cid, err = refs.CIDFromString(cidArg); err != nil {
 		return errors.Wrapf(err, "can't parse CID '%s'", cidArg)
-	} else if conn, err = connect(ctx, c); err != nil {
+	} else if err = objID.Parse(objArg); err != nil {
+		return errors.Wrapf(err, "can't parse object id '%s'", objArg)
+	} else if specs, err = parseRangeSpecs(c.Args().Slice()); err != nil {
+		return errors.Wrap(err, "can't parse ranges")
+	}
+
+	if conn, err = connect(ctx, c); err != nil {
 		return errors.Wrapf(err, "can't connect to host '%s'", host)
 	}
 
-	if err = objID.Parse(objArg); err != nil {
-		return errors.Wrapf(err, "can't parse object id '%s'", objArg)
+	var (
+		addr = refs.Address{
+			ObjectID: objID,
+			CID:      cid,
+		}
+
+		p = connectionParams{
+			ctx:  ctx,
+			cmd:  c,
+			conn: conn,
+		}
+	)
+
+	if len(specs) > 1 {
+		p.tokens = newTokenCache()
 	}
 
-	ranges, err = parseRanges(rngArg)
-	if err != nil {
-		return errors.Wrap(err, "can't parse ranges")
+	// suffix and open ranges are resolved with the payload length
+	if needSize(specs) {
+		obj, err := headObject(p, addr, false)
+		if err != nil {
+			return err
+		}
+
+		size = obj.SystemHeader.PayloadLength
 	}
 
-	if len(ranges) != 1 {
-		return errors.New("specify one range")
+	if ranges, err = resolveRanges(specs, size); err != nil {
+		return err
 	}
 
-	addr := refs.Address{
-		ObjectID: objID,
-		CID:      cid,
+	if fPath != "" && fPath != stdPath {
+		fd, err := os.OpenFile(fPath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, os.FileMode(c.Int(permFlag)))
+		if err != nil {
+			return errors.Wrapf(err, "can't open file %s", fPath)
+		}
+		defer fd.Close()
+
+		w = fd
+	}
+
+	for _, rng := range ranges {
+		rw, done, _ := rangeWriter(w, format)
+
+		if err := getRangeData(p, addr, rng, rw); err != nil {
+			return errors.Wrapf(err, "range %d:%d", rng.Offset, rng.Length)
+		} else if err := done(); err != nil {
+			return errors.Wrap(err, "get-range command failed on write")
+		}
+	}
+
+	if w == os.Stdout {
+		return nil
+	}
+
+	out := rangesFileOutput{
+		File:   fPath,
+		Ranges: make([]rangeOutput, 0, len(ranges)),
 	}
 
+	for _, rng := range ranges {
+		out.Ranges = append(out.Ranges, rangeOutput{Offset: rng.Offset, Length: rng.Length})
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "%d range(s) saved to %s\n", len(out.Ranges), out.File)
+		return err
+	})
+}
+
+// getRangeData receives payload range of the object and writes it to w.
+func getRangeData(p connectionParams, addr refs.Address, rng object.Range, w io.Writer) error {
+	c := p.cmd
+
 	token, err := createToken(tokenParams{
-		connectionParams: connectionParams{
-			ctx:  ctx,
-			cmd:  c,
-			conn: conn,
-		},
+		connectionParams: p,
 
 		addr: addr,
 
@@ -622,7 +843,7 @@ func getRange(c *cli.Context) error {
 
 	req := &object.GetRangeRequest{
 		Address: addr,
-		Range:   ranges[0],
+		Range:   rng,
 	}
 	req.SetToken(token)
 
@@ -635,12 +856,11 @@ func getRange(c *cli.Context) error {
 	setRaw(c, req)
 	signRequest(c, req)
 
-	rangeClient, err := object.NewServiceClient(conn).GetRange(ctx, req)
+	rangeClient, err := object.NewServiceClient(p.conn).GetRange(p.ctx, req)
 	if err != nil {
 		return errors.Wrap(err, "can't perform get-range request")
 	}
 
-	var result []byte
 	for {
 		resp, err := rangeClient.Recv()
 		if err != nil {
@@ -649,9 +869,11 @@ func getRange(c *cli.Context) error {
 			}
 			return errors.Wrap(err, "get-range command received error")
 		}
-		result = append(result, resp.Fragment...)
+
+		if _, err := w.Write(resp.Fragment); err != nil {
+			return errors.Wrap(err, "get-range command failed on write")
+		}
 	}
-	fmt.Println(hex.EncodeToString(result))
 
 	return nil
 }
@@ -745,9 +967,18 @@ func getRangeHash(c *cli.Context) error {
 		if fd, err = os.OpenFile(fPath, os.O_RDONLY, os.FileMode(perm)); err != nil {
 			return errors.Wrap(err, "could not open file")
 		}
+		defer fd.Close()
 	}
 
+	out := hashOutput{Ranges: make([]rangeHashOutput, 0, len(resp.Hashes))}
+
 	for i := range resp.Hashes {
+		res := rangeHashOutput{
+			Offset: ranges[i].Offset,
+			Length: ranges[i].Length,
+			Hash:   resp.Hashes[i].String(),
+		}
+
 		if verify {
 			d := make([]byte, ranges[i].Length)
 			if _, err = fd.ReadAt(d, int64(ranges[i].Offset)); err != nil && err != io.EOF {
@@ -756,16 +987,29 @@ func getRangeHash(c *cli.Context) error {
 
 			xor := hash.SaltXOR(d[:ranges[i].Length], salt)
 
-			fmt.Print("(")
-			if !hash.Sum(xor).Equal(resp.Hashes[i]) {
-				fmt.Print("in")
+			if res.Result = "valid"; !hash.Sum(xor).Equal(resp.Hashes[i]) {
+				res.Result = "invalid"
 			}
-			fmt.Print("valid) ")
 		}
-		fmt.Printf("%s\n", resp.Hashes[i])
+
+		out.Ranges = append(out.Ranges, res)
 	}
 
-	return nil
+	return printOutput(c, out, func(w io.Writer) error {
+		for _, res := range out.Ranges {
+			if res.Result != "" {
+				if _, err := fmt.Fprintf(w, "(%s) ", res.Result); err != nil {
+					return err
+				}
+			}
+
+			if _, err := fmt.Fprintln(w, res.Hash); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 func parseRanges(rng cli.Args) (ranges []object.Range, err error) {
@@ -795,24 +1039,20 @@ func parseRanges(rng cli.Args) (ranges []object.Range, err error) {
 
 func put(c *cli.Context) error {
 	var (
-		err   error
-		cid   refs.CID
-		conn  *grpc.ClientConn
-		fd    *os.File
-		fSize int64
+		err  error
+		cid  refs.CID
+		conn *grpc.ClientConn
 
 		key    = getKey(c)
 		host   = getHost(c)
 		sCID   = c.String(cidFlag)
 		fPaths = c.StringSlice(fileFlag)
-		perm   = c.Int(permFlag)
-		verify = c.Bool(verifyFlag)
+		dir    = c.String(dirFlag)
 		userH  = c.StringSlice(userHeaderFlag)
 		ctx    = gracefulContext()
-		cpNum  = c.Uint64(copiesNumFlag)
 	)
 
-	if sCID == "" || len(fPaths) == 0 {
+	if sCID == "" || (len(fPaths) == 0) == (dir == "") {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
 	}
 
@@ -827,162 +1067,429 @@ func put(c *cli.Context) error {
 		return errors.Wrap(err, "could not compute owner ID")
 	}
 
-	for i := range fPaths {
-		fPath := fPaths[i]
+	p := putParams{
+		connectionParams: connectionParams{
+			ctx:  ctx,
+			cmd:  c,
+			conn: conn,
+		},
+
+		cid:   cid,
+		owner: owner,
+	}
+
+	if cNum := c.Int(concurrencyFlag); cNum > 1 || len(fPaths) > 1 || dir != "" {
+		p.tokens = newTokenCache()
+	}
 
-		if fd, err = os.OpenFile(fPath, os.O_RDONLY, os.FileMode(perm)); err != nil {
-			return errors.Wrapf(err, "can't open file %s", fPath)
-		}
+	if dir != "" {
+		return putDir(p, dir, c.StringSlice(includeFlag), c.StringSlice(excludeFlag))
+	}
+
+	var (
+		mtx     = new(sync.Mutex)
+		results = make(map[string]*storedObjectOutput, len(fPaths))
+	)
 
-		fi, err := fd.Stat()
+	errs := runBatch(ctx, c.Int(concurrencyFlag), fPaths, func(fPath string) error {
+		res, err := putFile(p, fPath, parseUserHeaders(userH))
 		if err != nil {
-			return errors.Wrap(err, "can't get file info")
+			return err
 		}
 
-		fSize = fi.Size()
+		mtx.Lock()
+		results[fPath] = res
+		mtx.Unlock()
 
-		objID, err := refs.NewObjectID()
-		if err != nil {
-			return errors.Wrap(err, "can't generate new object ID")
+		return nil
+	})
+
+	// stored objects are printed by putFile in text format
+	if err := printOutput(c, objectPutOutputFrom(fPaths, results), skipText); err != nil {
+		return err
+	}
+
+	return batchSummary(messageWriter(c), fPaths, errs)
+}
+
+// putFile stores file as a single object with the passed headers
+// and returns the address of the stored object. Files larger than
+// maximum object size are split into several linked objects. Result is
+// printed right away in text format.
+func putFile(p putParams, fPath string, headers []object.Header) (*storedObjectOutput, error) {
+	var (
+		c       = p.cmd
+		perm    = c.Int(permFlag)
+		verify  = c.Bool(verifyFlag)
+		maxSize = int64(c.Uint64(maxSizeFlag)) * object.UnitsMB
+	)
+
+	var (
+		err error
+		fd  *os.File
+	)
+
+	if fPath == stdPath {
+		if fd, err = spoolStdin(); err != nil {
+			return nil, err
 		}
+		defer os.Remove(fd.Name())
 
-		token, err := createToken(tokenParams{
-			connectionParams: connectionParams{
-				ctx:  ctx,
-				cmd:  c,
-				conn: conn,
-			},
+		fPath = "stdin"
+	} else if fd, err = os.OpenFile(fPath, os.O_RDONLY, os.FileMode(perm)); err != nil {
+		return nil, errors.Wrapf(err, "can't open file %s", fPath)
+	}
+	defer fd.Close()
 
-			addr: refs.Address{
-				ObjectID: objID,
-				CID:      cid,
-			},
+	fi, err := fd.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get file info")
+	}
 
-			verb: service.Token_Info_Put,
-		})
+	fSize := fi.Size()
+
+	objID, err := refs.NewObjectID()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't generate new object ID")
+	}
+
+	obj := &object.Object{
+		SystemHeader: object.SystemHeader{
+			ID:            objID,
+			OwnerID:       p.owner,
+			CID:           p.cid,
+			PayloadLength: uint64(fSize),
+		},
+		Headers: headers,
+	}
+
+	var res *storedObjectOutput
+
+	if maxSize > 0 && fSize > maxSize {
+		if res, err = putSplitFile(p, obj, fd, fPath, maxSize); err != nil {
+			return nil, err
+		}
+	} else {
+		addr, h, err := putObject(p, obj, io.NewSectionReader(fd, 0, fSize), fPath)
 		if err != nil {
-			return errors.Wrap(err, "could not create session token")
+			return nil, err
 		}
 
-		client := object.NewServiceClient(conn)
-		putClient, err := client.Put(ctx)
-		if err != nil {
-			return errors.Wrap(err, "put command failed on client creation")
+		res = &storedObjectOutput{
+			File: fPath,
+			CID:  addr.CID.String(),
+			OID:  addr.ObjectID.String(),
 		}
 
-		var (
-			n      int
-			curOff int64
-			data   = make([]byte, dataChunkSize)
-			obj    = &object.Object{
-				SystemHeader: object.SystemHeader{
-					ID:            objID,
-					OwnerID:       owner,
-					CID:           cid,
-					PayloadLength: uint64(fSize),
-				},
-				Headers: parseUserHeaders(userH),
+		if verify {
+			if res.Verification, err = verifyObjectHash(p.connectionParams, *addr, obj.SystemHeader.PayloadLength, h); err != nil {
+				return nil, err
 			}
-		)
+		}
+	}
 
-		fmt.Printf("[%s] Sending header...\n", fPath)
+	if isTextOutput(c) {
+		if err = displayStoredObject(os.Stdout, res); err != nil {
+			return nil, err
+		}
+	}
 
-		req := &object.PutRequest{
-			R: &object.PutRequest_Header{
-				Header: &object.PutRequest_PutHeader{
-					Object:       obj,
-					CopiesNumber: uint32(cpNum),
-				},
-			},
+	return res, nil
+}
+
+func displayStoredObject(w io.Writer, res *storedObjectOutput) error {
+	if _, err := fmt.Fprintf(w, "[%s] Object successfully stored\n  ID: %s\n  CID: %s\n", res.File, res.OID, res.CID); err != nil {
+		return err
+	}
+
+	if res.Parts > 0 {
+		if _, err := fmt.Fprintf(w, "  Parts: %d\n", res.Parts); err != nil {
+			return err
 		}
-		req.SetToken(token)
+	}
 
-		if err := addBearerToken(c, &req.RequestVerificationHeader); err != nil {
-			return errors.Wrap(err, "could not attach Bearer token")
+	if res.Verification != "" {
+		if _, err := fmt.Fprintf(w, "Verification result: %s.\n", res.Verification); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+// putObject sends object header and payload read from r. It returns
+// the address of the stored object and homomorphic hash of the payload
+// if the object must be verified.
+func putObject(p putParams, obj *object.Object, r io.Reader, label string) (*refs.Address, hash.Hash, error) {
+	var (
+		c      = p.cmd
+		ctx    = p.ctx
+		verify = c.Bool(verifyFlag)
+		cpNum  = c.Uint64(copiesNumFlag)
+		h      = hash.Sum(nil)
+	)
 
-		req.SetHeaders(parseRequestHeaders(c.StringSlice(extHdrFlag)))
-		setTTL(c, req)
-		setRaw(c, req)
-		signRequest(c, req)
+	token, err := createToken(tokenParams{
+		connectionParams: p.connectionParams,
+
+		addr: refs.Address{
+			ObjectID: obj.SystemHeader.ID,
+			CID:      p.cid,
+		},
+
+		verb: service.Token_Info_Put,
+	})
+	if err != nil {
+		return nil, h, errors.Wrap(err, "could not create session token")
+	}
+
+	client := object.NewServiceClient(p.conn)
+	putClient, err := client.Put(ctx)
+	if err != nil {
+		return nil, h, errors.Wrap(err, "put command failed on client creation")
+	}
 
-		if err = putClient.Send(req); err != nil {
-			return errors.Wrap(err, "put command failed on Send object origin")
+	fmt.Fprintf(os.Stderr, "[%s] Sending header...\n", label)
+
+	req := &object.PutRequest{
+		R: &object.PutRequest_Header{
+			Header: &object.PutRequest_PutHeader{
+				Object:       obj,
+				CopiesNumber: uint32(cpNum),
+			},
+		},
+	}
+	req.SetToken(token)
+
+	if err := addBearerToken(c, &req.RequestVerificationHeader); err != nil {
+		return nil, h, errors.Wrap(err, "could not attach Bearer token")
+	}
+
+	req.SetHeaders(parseRequestHeaders(c.StringSlice(extHdrFlag)))
+	setTTL(c, req)
+	setRaw(c, req)
+	signRequest(c, req)
+
+	if err = putClient.Send(req); err != nil {
+		return nil, h, errors.Wrap(err, "put command failed on Send object origin")
+	}
+
+	fmt.Fprintf(os.Stderr, "[%s] Sending data...\n", label)
+
+	var (
+		n    int
+		data = make([]byte, dataChunkSize)
+	)
+
+	for err != io.EOF {
+		if n, err = io.ReadFull(r, data); err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		} else if err != nil && err != io.EOF {
+			return nil, h, errors.Wrap(err, "put command failed on file read")
 		}
 
-		fmt.Printf("[%s] Sending data...\n", fPath)
-		h := hash.Sum(nil)
-		for ; err != io.EOF; curOff += int64(n) {
-			if n, err = fd.ReadAt(data, curOff); err != nil && err != io.EOF {
-				return errors.Wrap(err, "put command failed on file read")
+		if n > 0 {
+			if verify {
+				h, _ = hash.Concat([]hash.Hash{h, hash.Sum(data[:n])})
 			}
 
-			if n > 0 {
-				if verify {
-					h, _ = hash.Concat([]hash.Hash{h, hash.Sum(data[:n])})
-				}
-
-				req := object.MakePutRequestChunk(data[:n])
-				setTTL(c, req)
-				setRaw(c, req)
-				signRequest(c, req)
+			req := object.MakePutRequestChunk(data[:n])
+			setTTL(c, req)
+			setRaw(c, req)
+			signRequest(c, req)
 
-				if err := putClient.Send(req); err != nil && err != io.EOF {
-					return errors.Wrap(err, "put command failed on Send")
-				}
+			if err := putClient.Send(req); err != nil && err != io.EOF {
+				return nil, h, errors.Wrap(err, "put command failed on Send")
 			}
 		}
+	}
+
+	resp, err := putClient.CloseAndRecv()
+	if err != nil {
+		return nil, h, errors.Wrap(err, "put command failed on CloseAndRecv")
+	}
+
+	addr := resp.GetAddress()
+
+	return &addr, h, nil
+}
+
+// verifyObjectHash compares homomorphic hash of the stored object payload
+// with the expected one and returns the verification result.
+func verifyObjectHash(p connectionParams, addr refs.Address, length uint64, h hash.Hash) (string, error) {
+	c := p.cmd
+
+	token, err := createToken(tokenParams{
+		connectionParams: p,
+
+		addr: addr,
+
+		verb: service.Token_Info_RangeHash,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "could not create session token")
+	}
+
+	req := &object.GetRangeHashRequest{
+		Address: addr,
+		Ranges:  []object.Range{{Offset: 0, Length: length}},
+	}
+	req.SetToken(token)
+	setTTL(c, req)
+	setRaw(c, req)
+	signRequest(c, req)
+
+	if r, err := object.NewServiceClient(p.conn).GetRangeHash(p.ctx, req); err != nil {
+		return "can't perform GETRANGEHASH request", nil
+	} else if len(r.Hashes) == 0 {
+		return "empty hash list received", nil
+	} else if !r.Hashes[0].Equal(h) {
+		return "hashes are not equal", nil
+	}
+
+	return "success", nil
+}
+
+// spoolStdin copies standard input into the temporary file,
+// since payload length must be known before sending the object header.
+func spoolStdin() (*os.File, error) {
+	fd, err := ioutil.TempFile("", "neofs-cli-stdin-")
+	if err != nil {
+		return nil, errors.Wrap(err, "can't create temporary file")
+	}
+
+	if _, err = io.Copy(fd, os.Stdin); err != nil {
+		fd.Close()
+		os.Remove(fd.Name())
+
+		return nil, errors.Wrap(err, "can't read standard input")
+	}
+
+	return fd, nil
+}
+
+// putDir walks through the directory tree and stores every regular file
+// that passes include/exclude patterns as a separate object.
+func putDir(p putParams, dir string, include, exclude []string) error {
+	var (
+		paths []string
+		infos = make(map[string]os.FileInfo)
+		userH = p.cmd.StringSlice(userHeaderFlag)
+
+		mtx     = new(sync.Mutex)
+		results = make(map[string]*storedObjectOutput)
+	)
+
+	if err := checkGlobs(include); err != nil {
+		return err
+	} else if err := checkGlobs(exclude); err != nil {
+		return err
+	}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		} else if !info.Mode().IsRegular() {
+			return nil
+		}
 
-		resp, err := putClient.CloseAndRecv()
+		rel, err := filepath.Rel(dir, path)
 		if err != nil {
-			return errors.Wrap(err, "put command failed on CloseAndRecv")
+			return err
 		}
 
-		addr := resp.GetAddress()
+		rel = filepath.ToSlash(rel)
 
-		fmt.Printf("[%s] Object successfully stored\n", fPath)
-		fmt.Printf("  ID: %s\n  CID: %s\n", addr.ObjectID, addr.CID)
-		if verify {
-			result := "success"
+		if len(include) > 0 && !matchGlobs(include, rel) {
+			return nil
+		} else if matchGlobs(exclude, rel) {
+			return nil
+		}
 
-			token, err := createToken(tokenParams{
-				connectionParams: connectionParams{
-					ctx:  ctx,
-					cmd:  c,
-					conn: conn,
-				},
+		paths = append(paths, rel)
+		infos[rel] = info
 
-				addr: addr,
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not walk directory %s", dir)
+	}
 
-				verb: service.Token_Info_RangeHash,
-			})
-			if err != nil {
-				return errors.Wrap(err, "could not create session token")
-			}
+	errs := runBatch(p.ctx, p.cmd.Int(concurrencyFlag), paths, func(rel string) error {
+		headers := append(parseUserHeaders(userH), fileHeaders(rel, infos[rel])...)
 
-			req := &object.GetRangeHashRequest{
-				Address: addr,
-				Ranges:  []object.Range{{Offset: 0, Length: obj.SystemHeader.PayloadLength}},
-			}
-			req.SetToken(token)
-			setTTL(c, req)
-			setRaw(c, req)
-			signRequest(c, req)
+		res, err := putFile(p, filepath.Join(dir, filepath.FromSlash(rel)), headers)
+		if err != nil {
+			return err
+		}
+
+		res.File = rel
+
+		mtx.Lock()
+		results[rel] = res
+		mtx.Unlock()
+
+		return nil
+	})
+
+	out := objectPutOutputFrom(paths, results)
+
+	err = printOutput(p.cmd, out, func(w io.Writer) error {
+		if _, err := fmt.Fprintln(w, "\nManifest:"); err != nil {
+			return err
+		}
 
-			if r, err := client.GetRangeHash(ctx, req); err != nil {
-				result = "can't perform GETRANGEHASH request"
-			} else if len(r.Hashes) == 0 {
-				result = "empty hash list received"
-			} else if !r.Hashes[0].Equal(h) {
-				result = "hashes are not equal"
+		tw := tabwriter.NewWriter(w, 1, 8, 3, ' ', 0)
+		for _, res := range out.Objects {
+			if _, err := fmt.Fprintf(tw, "%s\t%s\n", res.File, res.OID); err != nil {
+				return err
 			}
-			fmt.Printf("Verification result: %s.\n", result)
+		}
+
+		return tw.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	return batchSummary(messageWriter(p.cmd), paths, errs)
+}
+
+// checkGlobs checks syntax of the patterns, so that malformed
+// pattern is not silently treated as not matching.
+func checkGlobs(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid pattern %q", pattern)
 		}
 	}
 
 	return nil
 }
 
+// matchGlobs checks if relative path or its base name matches any pattern.
+func matchGlobs(patterns []string, rel string) bool {
+	for i := range patterns {
+		if ok, _ := filepath.Match(patterns[i], rel); ok {
+			return true
+		} else if ok, _ = filepath.Match(patterns[i], filepath.Base(rel)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileHeaders returns user headers that describe stored file.
+func fileHeaders(rel string, info os.FileInfo) []object.Header {
+	return parseUserHeaders([]string{
+		filePathHeader + "=" + rel,
+		fileNameHeader + "=" + info.Name(),
+		fileSizeHeader + "=" + strconv.FormatInt(info.Size(), 10),
+		fileMTimeHeader + "=" + strconv.FormatInt(info.ModTime().Unix(), 10),
+	})
+}
+
 func parseUserHeaders(userH []string) (headers []object.Header) {
 	headers = make([]object.Header, len(userH))
 	for i := range userH {
@@ -1037,6 +1544,14 @@ func establishSession(p sessionParams) error {
 }
 
 func createToken(p tokenParams) (*service.Token, error) {
+	if p.tokens != nil {
+		return p.tokens.get(p)
+	}
+
+	return newToken(p)
+}
+
+func newToken(p tokenParams) (*service.Token, error) {
 	key := getKey(p.cmd)
 
 	ownerID, err := refs.NewOwnerID(&key.PublicKey)
@@ -1061,7 +1576,7 @@ func createToken(p tokenParams) (*service.Token, error) {
 	}
 
 	// sign token message
-	if err := service.AddSignatureWithKey(
+	if err := addSignatureWithKey(
 		key,
 		service.NewSignedSessionToken(token),
 	); err != nil {
@@ -1074,44 +1589,114 @@ func createToken(p tokenParams) (*service.Token, error) {
 func get(c *cli.Context) error {
 	var (
 		err  error
-		fd   *os.File
 		cid  refs.CID
-		oid  refs.ObjectID
 		conn *grpc.ClientConn
 
 		host  = getHost(c)
 		sCID  = c.String(cidFlag)
-		sOID  = c.String(objFlag)
+		sOIDs = c.StringSlice(objFlag)
 		fPath = c.String(fileFlag)
-		perm  = c.Int(permFlag)
+		dir   = c.String(dirFlag)
+		cNum  = c.Int(concurrencyFlag)
 		ctx   = gracefulContext()
 	)
 
-	if sCID == "" || sOID == "" || len(fPath) == 0 {
+	if sCID == "" || len(sOIDs) == 0 {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	} else if len(sOIDs) == 1 && (len(fPath) == 0) == (dir == "") {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	} else if len(sOIDs) > 1 && dir == "" {
+		return errors.New("specify output directory (--dir) to get several objects")
+	} else if fPath == stdPath && c.Bool(resumeFlag) {
+		return errors.Errorf("--%s can't be used with standard output", resumeFlag)
 	}
 
 	if cid, err = refs.CIDFromString(sCID); err != nil {
 		return errors.Wrapf(err, "can't parse CID %s", sCID)
-	} else if conn, err = connect(ctx, c); err != nil {
+	}
+
+	oids := make([]refs.ObjectID, len(sOIDs))
+	for i := range sOIDs {
+		if err = oids[i].Parse(sOIDs[i]); err != nil {
+			return errors.Wrapf(err, "can't parse Object ID %s", sOIDs[i])
+		}
+	}
+
+	if conn, err = connect(ctx, c); err != nil {
 		return errors.Wrapf(err, "can't connect to host '%s'", host)
 	}
 
-	if err = oid.Parse(sOID); err != nil {
-		return errors.Wrapf(err, "can't parse Object ID %s", sOID)
+	p := connectionParams{
+		ctx:  ctx,
+		cmd:  c,
+		conn: conn,
 	}
 
-	addr := refs.Address{
-		ObjectID: oid,
-		CID:      cid,
+	if dir == "" {
+		if err = getObject(p, refs.Address{ObjectID: oids[0], CID: cid}, fPath, true); err != nil || fPath == stdPath {
+			// standard output is taken by the payload
+			return err
+		}
+
+		return printOutput(c, objectGetOutputFrom(cid, sOIDs, []string{fPath}, []error{nil}), skipText)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrapf(err, "can't create directory %s", dir)
+	}
+
+	p.tokens = newTokenCache()
+
+	fPaths := make([]string, len(sOIDs))
+	for i := range sOIDs {
+		fPaths[i] = filepath.Join(dir, sOIDs[i])
+	}
+
+	errs := runBatch(ctx, cNum, sOIDs, func(sOID string) error {
+		var oid refs.ObjectID
+
+		// already validated above
+		_ = oid.Parse(sOID)
+
+		return getObject(p, refs.Address{ObjectID: oid, CID: cid}, filepath.Join(dir, sOID), false)
+	})
+
+	// fetched objects are printed by getObject in text format
+	if err := printOutput(c, objectGetOutputFrom(cid, sOIDs, fPaths, errs), skipText); err != nil {
+		return err
+	}
+
+	return batchSummary(messageWriter(c), sOIDs, errs)
+}
+
+// getObject receives object payload and writes it into the file.
+//
+// If progress is false, only the final result is printed.
+func getObject(p connectionParams, addr refs.Address, fPath string, progress bool) error {
+	var (
+		err error
+		fd  *os.File
+		w   io.Writer
+		obj *object.Object
+
+		c        = p.cmd
+		perm     = c.Int(permFlag)
+		log      = messageWriter(c)
+		verify   = !c.Bool(noVerifyFlag)
+		verifier = newPayloadVerifier()
+	)
+
+	if fPath == stdPath {
+		// keep standard output clean for the payload
+		log = os.Stderr
+	} else if c.Bool(resumeFlag) {
+		if fi, err := os.Stat(fPath); err == nil && fi.Size() > 0 {
+			return resumeObject(p, addr, fPath, fi.Size(), progress, log)
+		}
 	}
 
 	token, err := createToken(tokenParams{
-		connectionParams: connectionParams{
-			ctx:  ctx,
-			cmd:  c,
-			conn: conn,
-		},
+		connectionParams: p,
 
 		addr: addr,
 
@@ -1135,15 +1720,23 @@ func get(c *cli.Context) error {
 	setRaw(c, req)
 	signRequest(c, req)
 
-	getClient, err := object.NewServiceClient(conn).Get(ctx, req)
+	getClient, err := object.NewServiceClient(p.conn).Get(p.ctx, req)
 	if err != nil {
 		return errors.Wrap(err, "get command failed on client creation")
 	}
 
-	fmt.Println("Waiting for data...")
+	if progress {
+		fmt.Fprintln(log, "Waiting for data...")
+	}
 
 	var objectOriginReceived bool
 
+	defer func() {
+		if fd != nil && fd != os.Stdout {
+			fd.Close()
+		}
+	}()
+
 	for {
 		resp, err := getClient.Recv()
 		if err != nil {
@@ -1154,40 +1747,171 @@ func get(c *cli.Context) error {
 		}
 
 		if !objectOriginReceived {
-			obj := resp.GetObject()
+			obj = resp.GetObject()
 
 			if _, hdr := obj.LastHeader(object.HeaderType(object.TombstoneHdr)); hdr != nil {
 				if err := obj.Verify(); err != nil {
-					fmt.Println("Object corrupted")
+					fmt.Fprintln(log, "Object corrupted")
 					return err
 				}
 				return errors.New("Object removed")
 			}
 
-			fmt.Printf("Object origin received: %s\n", resp.GetObject().SystemHeader.ID)
+			if verify {
+				if err := verifyObjectHeaders(obj); err != nil {
+					return errors.Wrapf(err, "object %s", addr.ObjectID)
+				}
+			}
+
+			if obj.IsLinking() {
+				return getSplitObject(p, obj, fPath, progress, log)
+			}
+
+			if progress {
+				fmt.Fprintf(log, "Object origin received: %s\n", resp.GetObject().SystemHeader.ID)
+			}
 
-			if fd, err = os.OpenFile(fPath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, os.FileMode(perm)); err != nil {
+			if fPath == stdPath {
+				fd = os.Stdout
+			} else if fd, err = os.OpenFile(fPath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, os.FileMode(perm)); err != nil {
 				return errors.Wrapf(err, "can't open file %s", fPath)
 			}
 
-			if _, err := fd.Write(obj.Payload); err != nil && err != io.EOF {
+			if w = fd; verify {
+				w = io.MultiWriter(fd, verifier)
+			}
+
+			if _, err := w.Write(obj.Payload); err != nil && err != io.EOF {
 				return errors.Wrap(err, "get command failed on file write")
 			}
 			objectOriginReceived = true
-			fmt.Print("receiving chunks: ")
+			if progress {
+				fmt.Fprint(log, "receiving chunks: ")
+			}
 			continue
 		}
 
 		chunk := resp.GetChunk()
 
-		fmt.Print("#")
+		if progress {
+			fmt.Fprint(log, "#")
+		}
 
-		if _, err := fd.Write(chunk); err != nil && err != io.EOF {
+		if _, err := w.Write(chunk); err != nil && err != io.EOF {
 			return errors.Wrap(err, "get command failed on file write")
 		}
 	}
 
-	fmt.Println("\nObject successfully fetched")
+	if verify && obj != nil {
+		if err := verifier.verify(obj); err != nil {
+			if progress {
+				fmt.Fprintln(log)
+			}
+
+			quarantineFile(log, fPath)
+
+			return errors.Wrapf(err, "object %s", addr.ObjectID)
+		}
+	}
+
+	if progress {
+		fmt.Fprintln(log, "\nObject successfully fetched")
+	} else {
+		fmt.Fprintf(log, "[%s] Object successfully fetched\n", addr.ObjectID)
+	}
 
 	return nil
 }
+
+// resumeObject receives missing tail of the partial object payload
+// stored in the file by ranges and verifies payload of the result.
+func resumeObject(p connectionParams, addr refs.Address, fPath string, offset int64, progress bool, log io.Writer) error {
+	obj, err := headObject(p, addr, true)
+	if err != nil {
+		return err
+	} else if obj.IsTombstone() {
+		return errors.New("Object removed")
+	}
+
+	verify := !p.cmd.Bool(noVerifyFlag)
+	if verify {
+		if err := verifyObjectHeaders(obj); err != nil {
+			return errors.Wrapf(err, "object %s", addr.ObjectID)
+		}
+	}
+
+	if obj.IsLinking() {
+		fmt.Fprintf(log, "[%s] Split object can't be resumed, fetching from the start\n", addr.ObjectID)
+		return getSplitObject(p, obj, fPath, progress, log)
+	}
+
+	size := int64(obj.SystemHeader.PayloadLength)
+
+	ranges, err := resumeRanges(offset, size, dataChunkSize)
+	if err != nil {
+		return errors.Wrapf(err, "file %s", fPath)
+	}
+
+	fd, err := os.OpenFile(fPath, os.O_WRONLY|os.O_APPEND, os.FileMode(p.cmd.Int(permFlag)))
+	if err != nil {
+		return errors.Wrapf(err, "can't open file %s", fPath)
+	}
+	defer fd.Close()
+
+	if progress {
+		fmt.Fprintf(log, "Resuming from %d of %d bytes: ", offset, size)
+	}
+
+	for _, rng := range ranges {
+		if err := getRangeData(p, addr, rng, fd); err != nil {
+			return err
+		}
+
+		if progress {
+			fmt.Fprint(log, "#")
+		}
+	}
+
+	if progress {
+		fmt.Fprintln(log)
+	}
+
+	if verify {
+		if err := verifyFilePayload(fPath, obj); err != nil {
+			if errors.Cause(err) == errPayloadVerification {
+				quarantineFile(log, fPath)
+			}
+
+			return errors.Wrapf(err, "object %s", addr.ObjectID)
+		}
+	}
+
+	if progress {
+		fmt.Fprintln(log, "Object successfully fetched")
+	} else {
+		fmt.Fprintf(log, "[%s] Object successfully fetched\n", addr.ObjectID)
+	}
+
+	return nil
+}
+
+// resumeRanges returns payload ranges of at most chunk bytes
+// that follow the first offset bytes of the payload.
+func resumeRanges(offset, size, chunk int64) ([]object.Range, error) {
+	if offset > size {
+		return nil, errors.Errorf("partial payload is larger than object payload (%d > %d)", offset, size)
+	}
+
+	res := make([]object.Range, 0, (size-offset+chunk-1)/chunk)
+
+	for ; offset < size; offset += chunk {
+		length := size - offset
+		if length > chunk {
+			length = chunk
+		}
+
+		res = append(res, object.Range{Offset: uint64(offset), Length: uint64(length)})
+	}
+
+	return res, nil
+}
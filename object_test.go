@@ -2,14 +2,19 @@ package main
 
 import (
 	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/query"
 	"github.com/nspcc-dev/neofs-api-go/refs"
 	"github.com/nspcc-dev/neofs-api-go/service"
 	"github.com/nspcc-dev/neofs-api-go/storagegroup"
 	"github.com/nspcc-dev/neofs-crypto/test"
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
 )
 
 func TestStringify(t *testing.T) {
@@ -172,3 +177,120 @@ func TestStringify(t *testing.T) {
 	require.NoError(t, objectStringify(buf, obj))
 	require.Equal(t, res, buf.String())
 }
+
+func Test_matchGlobs(t *testing.T) {
+	cases := []struct {
+		patterns []string
+		path     string
+		result   bool
+	}{
+		{patterns: []string{"*.txt"}, path: "a.txt", result: true},
+		{patterns: []string{"*.txt"}, path: "dir/a.txt", result: true},
+		{patterns: []string{"dir/*"}, path: "dir/a.txt", result: true},
+		{patterns: []string{"dir/*"}, path: "other/a.txt", result: false},
+		{patterns: []string{"*.png", "*.jpg"}, path: "a.txt", result: false},
+		{patterns: nil, path: "a.txt", result: false},
+	}
+
+	for i := range cases {
+		require.Equal(t, cases[i].result, matchGlobs(cases[i].patterns, cases[i].path), cases[i].path)
+	}
+}
+
+func Test_checkGlobs(t *testing.T) {
+	require.NoError(t, checkGlobs(nil))
+	require.NoError(t, checkGlobs([]string{"*.txt", "dir/*", "[a-z]?.png"}))
+
+	err := checkGlobs([]string{"*.txt", "[a-"})
+	require.EqualError(t, err, `invalid pattern "[a-": syntax error in pattern`)
+}
+
+func Test_resumeRanges(t *testing.T) {
+	ranges, err := resumeRanges(3, 10, 4)
+	require.NoError(t, err)
+	require.Equal(t, []object.Range{
+		{Offset: 3, Length: 4},
+		{Offset: 7, Length: 3},
+	}, ranges)
+
+	ranges, err = resumeRanges(2, 10, 4)
+	require.NoError(t, err)
+	require.Equal(t, []object.Range{
+		{Offset: 2, Length: 4},
+		{Offset: 6, Length: 4},
+	}, ranges)
+
+	// file is already complete
+	ranges, err = resumeRanges(10, 10, 4)
+	require.NoError(t, err)
+	require.Empty(t, ranges)
+
+	_, err = resumeRanges(11, 10, 4)
+	require.Error(t, err)
+}
+
+func Test_spoolStdin(t *testing.T) {
+	data := []byte("payload from standard input")
+
+	src, err := ioutil.TempFile("", "neofs-cli-test-")
+	require.NoError(t, err)
+
+	defer os.Remove(src.Name())
+
+	_, err = src.Write(data)
+	require.NoError(t, err)
+	_, err = src.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	stdin := os.Stdin
+	os.Stdin = src
+
+	defer func() { os.Stdin = stdin }()
+
+	fd, err := spoolStdin()
+	require.NoError(t, err)
+
+	defer os.Remove(fd.Name())
+	defer fd.Close()
+
+	fi, err := fd.Stat()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), fi.Size())
+
+	res, err := ioutil.ReadAll(io.NewSectionReader(fd, 0, fi.Size()))
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+}
+
+func Test_get_resumeStdout(t *testing.T) {
+	c := newTestContext(t, append([]cli.Flag{hostAddr}, getObjectAction.Flags...),
+		"--host", "127.0.0.1:8080",
+		"--cid", refs.CID{}.String(),
+		"--oid", "7e0b9c6c-aabc-4985-949e-2680e577b48b",
+		"--file", stdPath,
+		"--resume",
+	)
+
+	require.EqualError(t, get(c), "--resume can't be used with standard output")
+}
+
+func Test_searchFilters(t *testing.T) {
+	newContext := func(t *testing.T, args ...string) *cli.Context {
+		return newTestContext(t, []cli.Flag{searchRoot, searchQuery, storageGroup}, args...)
+	}
+
+	filters, err := searchFilters(newContext(t))
+	require.NoError(t, err)
+	require.Empty(t, filters)
+
+	filters, err = searchFilters(newContext(t, "--root", "--query", `FileName == "a.txt"`, "Project", "X"))
+	require.NoError(t, err)
+	require.Equal(t, []query.Filter{
+		{Type: query.Filter_Exact, Name: "FileName", Value: "a.txt"},
+		{Type: query.Filter_Regex, Name: "Project", Value: "X"},
+		{Type: query.Filter_Exact, Name: object.KeyRootObject},
+	}, filters)
+
+	_, err = searchFilters(newContext(t, "Project"))
+	require.Error(t, err)
+}
@@ -0,0 +1,638 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/accounting"
+	"github.com/nspcc-dev/neofs-api-go/bootstrap"
+	"github.com/nspcc-dev/neofs-api-go/decimal"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/nspcc-dev/neofs-api-go/service"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	outputFlag = "output"
+
+	outputText = "text"
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+type (
+	containerListOutput struct {
+		Containers []string `json:"containers" yaml:"containers"`
+	}
+
+	containerOutput struct {
+		ID        string `json:"id" yaml:"id"`
+		OwnerID   string `json:"owner_id" yaml:"owner_id"`
+		Capacity  uint64 `json:"capacity" yaml:"capacity"`
+		Placement string `json:"placement" yaml:"placement"`
+		Salt      string `json:"salt" yaml:"salt"`
+		BasicACL  string `json:"basic_acl" yaml:"basic_acl"`
+
+		Permissions basicACLOutput `json:"permissions" yaml:"permissions"`
+	}
+
+	basicACLOutput struct {
+		Sticky     bool                      `json:"sticky" yaml:"sticky"`
+		Final      bool                      `json:"final" yaml:"final"`
+		Operations []basicACLOperationOutput `json:"operations" yaml:"operations"`
+	}
+
+	basicACLOperationOutput struct {
+		Operation string   `json:"operation" yaml:"operation"`
+		Roles     []string `json:"roles" yaml:"roles"`
+	}
+
+	containerPutOutput struct {
+		ID       string `json:"id" yaml:"id"`
+		Accepted bool   `json:"accepted" yaml:"accepted"`
+		EACLSet  bool   `json:"eacl_set" yaml:"eacl_set"`
+	}
+
+	containerDeleteOutput struct {
+		ID      string `json:"id" yaml:"id"`
+		Removed bool   `json:"removed" yaml:"removed"`
+	}
+
+	// containerEACLOutput contains either hex encoded extended ACL table
+	// or its rules. Rules output is a valid rules file.
+	containerEACLOutput struct {
+		EACL    string            `json:"eacl,omitempty" yaml:"eacl,omitempty"`
+		Records []eaclRulesRecord `json:"records,omitempty" yaml:"records,omitempty"`
+	}
+
+	containerApplyOutput struct {
+		ID    string                 `json:"id" yaml:"id"`
+		Drift []containerDriftOutput `json:"drift" yaml:"drift"`
+	}
+
+	containerDriftOutput struct {
+		Field string `json:"field" yaml:"field"`
+		Spec  string `json:"spec" yaml:"spec"`
+		Live  string `json:"live" yaml:"live"`
+	}
+
+	objectOutput struct {
+		SystemHeader systemHeaderOutput `json:"system_header" yaml:"system_header"`
+		Headers      []headerOutput     `json:"headers" yaml:"headers"`
+		Payload      string             `json:"payload,omitempty" yaml:"payload,omitempty"`
+	}
+
+	systemHeaderOutput struct {
+		ID            string `json:"id" yaml:"id"`
+		CID           string `json:"cid" yaml:"cid"`
+		OwnerID       string `json:"owner_id" yaml:"owner_id"`
+		Version       uint64 `json:"version" yaml:"version"`
+		PayloadLength uint64 `json:"payload_length" yaml:"payload_length"`
+		CreatedUnix   int64  `json:"created_unix" yaml:"created_unix"`
+		CreatedEpoch  uint64 `json:"created_epoch" yaml:"created_epoch"`
+	}
+
+	headerOutput struct {
+		Type  string            `json:"type" yaml:"type"`
+		Value map[string]string `json:"value" yaml:"value"`
+	}
+
+	objectPutOutput struct {
+		Objects []storedObjectOutput `json:"objects" yaml:"objects"`
+	}
+
+	storedObjectOutput struct {
+		File         string `json:"file" yaml:"file"`
+		CID          string `json:"cid" yaml:"cid"`
+		OID          string `json:"oid" yaml:"oid"`
+		Parts        int    `json:"parts,omitempty" yaml:"parts,omitempty"`
+		Verification string `json:"verification,omitempty" yaml:"verification,omitempty"`
+	}
+
+	objectGetOutput struct {
+		Objects []fetchedObjectOutput `json:"objects" yaml:"objects"`
+	}
+
+	fetchedObjectOutput struct {
+		CID  string `json:"cid" yaml:"cid"`
+		OID  string `json:"oid" yaml:"oid"`
+		File string `json:"file" yaml:"file"`
+	}
+
+	searchOutput struct {
+		Objects []addressOutput `json:"objects" yaml:"objects"`
+	}
+
+	addressOutput struct {
+		CID string `json:"cid" yaml:"cid"`
+		OID string `json:"oid" yaml:"oid"`
+	}
+
+	balanceOutput struct {
+		Active string       `json:"active" yaml:"active"`
+		Locked string       `json:"locked" yaml:"locked"`
+		Locks  []lockOutput `json:"locks" yaml:"locks"`
+	}
+
+	lockOutput struct {
+		Amount   string `json:"amount" yaml:"amount"`
+		Target   string `json:"target" yaml:"target"`
+		Lifetime int64  `json:"lifetime" yaml:"lifetime"`
+		Unit     string `json:"unit" yaml:"unit"`
+	}
+
+	withdrawalOutput struct {
+		ID         string            `json:"id" yaml:"id"`
+		OwnerID    string            `json:"owner_id" yaml:"owner_id"`
+		Amount     string            `json:"amount" yaml:"amount"`
+		Height     uint64            `json:"height" yaml:"height"`
+		Signatures []signatureOutput `json:"signatures,omitempty" yaml:"signatures,omitempty"`
+		Cheque     string            `json:"cheque,omitempty" yaml:"cheque,omitempty"`
+	}
+
+	signatureOutput struct {
+		Hash string `json:"hash" yaml:"hash"`
+		Key  string `json:"key" yaml:"key"`
+	}
+
+	chequeVerifyOutput struct {
+		ID         string                  `json:"id" yaml:"id"`
+		OwnerID    string                  `json:"owner_id" yaml:"owner_id"`
+		Amount     string                  `json:"amount" yaml:"amount"`
+		Height     uint64                  `json:"height" yaml:"height"`
+		Signatures []chequeSignatureOutput `json:"signatures" yaml:"signatures"`
+		Quorum     uint                    `json:"quorum,omitempty" yaml:"quorum,omitempty"`
+		Confirmed  uint                    `json:"confirmed,omitempty" yaml:"confirmed,omitempty"`
+		Passed     bool                    `json:"passed" yaml:"passed"`
+	}
+
+	chequeSignatureOutput struct {
+		Key       string `json:"key" yaml:"key"`
+		InnerRing bool   `json:"inner_ring" yaml:"inner_ring"`
+		Result    string `json:"result" yaml:"result"`
+	}
+
+	contractCallOutput struct {
+		JSONRPC string        `json:"jsonrpc"`
+		Method  string        `json:"method"`
+		Params  []interface{} `json:"params"`
+		ID      int           `json:"id"`
+	}
+
+	contractParamOutput struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+
+	chequeFileOutput struct {
+		ID     string `json:"id" yaml:"id"`
+		Format string `json:"format" yaml:"format"`
+		File   string `json:"file" yaml:"file"`
+	}
+
+	withdrawalListOutput struct {
+		Withdrawals []withdrawalOutput `json:"withdrawals" yaml:"withdrawals"`
+	}
+
+	bearerOutput struct {
+		OwnerID         string         `json:"owner_id" yaml:"owner_id"`
+		OwnerKey        string         `json:"owner_key" yaml:"owner_key"`
+		ExpirationEpoch uint64         `json:"expiration_epoch" yaml:"expiration_epoch"`
+		Rules           eaclRulesTable `json:"rules" yaml:"rules"`
+		Valid           bool           `json:"valid" yaml:"valid"`
+		Error           string         `json:"error,omitempty" yaml:"error,omitempty"`
+	}
+
+	bearerFileOutput struct {
+		OwnerID         string `json:"owner_id" yaml:"owner_id"`
+		ExpirationEpoch uint64 `json:"expiration_epoch" yaml:"expiration_epoch"`
+		File            string `json:"file" yaml:"file"`
+	}
+
+	profileListOutput struct {
+		Current  string   `json:"current" yaml:"current"`
+		Profiles []string `json:"profiles" yaml:"profiles"`
+	}
+
+	profileUseOutput struct {
+		Current string `json:"current" yaml:"current"`
+	}
+
+	profileDeleteOutput struct {
+		Deleted string `json:"deleted" yaml:"deleted"`
+	}
+
+	profileOutput struct {
+		Name        string   `json:"name" yaml:"name"`
+		Current     bool     `json:"current" yaml:"current"`
+		Host        string   `json:"host" yaml:"host"`
+		Key         string   `json:"key" yaml:"key"`
+		TTL         uint     `json:"ttl" yaml:"ttl"`
+		XHeaders    []string `json:"xhdr" yaml:"xhdr"`
+		DialTimeout string   `json:"dial_timeout" yaml:"dial_timeout"`
+		Timeout     string   `json:"timeout" yaml:"timeout"`
+
+		TLS                bool   `json:"tls" yaml:"tls"`
+		CACert             string `json:"ca_cert" yaml:"ca_cert"`
+		ClientCert         string `json:"client_cert" yaml:"client_cert"`
+		ClientKey          string `json:"client_key" yaml:"client_key"`
+		InsecureSkipVerify bool   `json:"insecure_skip_verify" yaml:"insecure_skip_verify"`
+	}
+
+	hashOutput struct {
+		Ranges []rangeHashOutput `json:"ranges" yaml:"ranges"`
+		Concat string            `json:"concat,omitempty" yaml:"concat,omitempty"`
+	}
+
+	rangeHashOutput struct {
+		Offset uint64 `json:"offset" yaml:"offset"`
+		Length uint64 `json:"length" yaml:"length"`
+		Hash   string `json:"hash" yaml:"hash"`
+		Result string `json:"result,omitempty" yaml:"result,omitempty"`
+	}
+
+	rangesFileOutput struct {
+		File   string        `json:"file" yaml:"file"`
+		Ranges []rangeOutput `json:"ranges" yaml:"ranges"`
+	}
+
+	rangeOutput struct {
+		Offset uint64 `json:"offset" yaml:"offset"`
+		Length uint64 `json:"length" yaml:"length"`
+	}
+
+	keyFileOutput struct {
+		Format string `json:"format" yaml:"format"`
+		File   string `json:"file" yaml:"file"`
+	}
+
+	keyInfoOutput struct {
+		PublicKey string `json:"public_key" yaml:"public_key"`
+		Address   string `json:"address" yaml:"address"`
+		OwnerID   string `json:"owner_id" yaml:"owner_id"`
+		OwnerHex  string `json:"owner_id_hex" yaml:"owner_id_hex"`
+	}
+
+	changeStateOutput struct {
+		State string `json:"state" yaml:"state"`
+	}
+
+	healthOutput struct {
+		Healthy bool   `json:"healthy" yaml:"healthy"`
+		Status  string `json:"status" yaml:"status"`
+	}
+
+	epochOutput struct {
+		Epoch uint64 `json:"epoch" yaml:"epoch"`
+	}
+
+	netmapOutput struct {
+		Epoch uint64       `json:"epoch" yaml:"epoch"`
+		Nodes []nodeOutput `json:"nodes" yaml:"nodes"`
+	}
+
+	nodeOutput struct {
+		Address   string   `json:"address" yaml:"address"`
+		PublicKey string   `json:"public_key" yaml:"public_key"`
+		Options   []string `json:"options" yaml:"options"`
+		Status    uint64   `json:"status" yaml:"status"`
+	}
+
+	sgOutput struct {
+		ID       string   `json:"id" yaml:"id"`
+		CID      string   `json:"cid" yaml:"cid"`
+		OwnerID  string   `json:"owner_id" yaml:"owner_id"`
+		Size     uint64   `json:"size" yaml:"size"`
+		Hash     string   `json:"hash" yaml:"hash"`
+		Lifetime string   `json:"lifetime" yaml:"lifetime"`
+		Members  []string `json:"members" yaml:"members"`
+	}
+
+	sgVerifyOutput struct {
+		ID           string           `json:"id" yaml:"id"`
+		Lifetime     string           `json:"lifetime" yaml:"lifetime"`
+		Members      []sgMemberOutput `json:"members" yaml:"members"`
+		Size         uint64           `json:"size" yaml:"size"`
+		ExpectedSize uint64           `json:"expected_size" yaml:"expected_size"`
+		Hash         string           `json:"hash" yaml:"hash"`
+		ExpectedHash string           `json:"expected_hash" yaml:"expected_hash"`
+		Passed       bool             `json:"passed" yaml:"passed"`
+	}
+
+	sgMemberOutput struct {
+		ID     string `json:"id" yaml:"id"`
+		Size   uint64 `json:"size" yaml:"size"`
+		Result string `json:"result" yaml:"result"`
+	}
+
+	placementOutput struct {
+		Placement string       `json:"placement" yaml:"placement"`
+		Container []nodeOutput `json:"container" yaml:"container"`
+		Object    []nodeOutput `json:"object,omitempty" yaml:"object,omitempty"`
+	}
+)
+
+var outputFormat = &cli.StringFlag{
+	Name:  outputFlag,
+	Usage: "output format: text, json or yaml",
+	Value: outputText,
+}
+
+// printOutput writes v in the format selected by --output flag.
+// Text format is written by the passed function.
+func printOutput(c *cli.Context, v interface{}, text func(io.Writer) error) error {
+	return writeOutput(os.Stdout, c.String(outputFlag), v, text)
+}
+
+// messageWriter returns writer for human-readable messages of the command.
+// Messages go to standard error output if result is printed in json or yaml,
+// so they don't break machine-readable output.
+func messageWriter(c *cli.Context) io.Writer {
+	if isTextOutput(c) {
+		return os.Stdout
+	}
+
+	return os.Stderr
+}
+
+// isTextOutput checks if result is printed in human-readable format.
+func isTextOutput(c *cli.Context) bool {
+	format := c.String(outputFlag)
+
+	return format == outputText || format == ""
+}
+
+// skipText is a text format function of commands that print
+// human-readable messages while running.
+func skipText(io.Writer) error { return nil }
+
+func writeOutput(w io.Writer, format string, v interface{}, text func(io.Writer) error) error {
+	switch format {
+	case outputText, "":
+		return text(w)
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+
+		return enc.Encode(v)
+	case outputYAML:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(data)
+
+		return err
+	default:
+		return errors.Errorf("unknown output format: %q", format)
+	}
+}
+
+func objectOutputFrom(obj *object.Object) objectOutput {
+	res := objectOutput{
+		SystemHeader: systemHeaderOutput{
+			ID:            obj.SystemHeader.ID.String(),
+			CID:           obj.SystemHeader.CID.String(),
+			OwnerID:       obj.SystemHeader.OwnerID.String(),
+			Version:       obj.SystemHeader.Version,
+			PayloadLength: obj.SystemHeader.PayloadLength,
+			CreatedUnix:   obj.SystemHeader.CreatedAt.UnixTime,
+			CreatedEpoch:  obj.SystemHeader.CreatedAt.Epoch,
+		},
+		Headers: make([]headerOutput, 0, len(obj.Headers)),
+	}
+
+	for i := range obj.Headers {
+		res.Headers = append(res.Headers, headerOutputFrom(obj.Headers[i]))
+	}
+
+	if len(obj.Payload) > 0 {
+		res.Payload = hex.EncodeToString(obj.Payload)
+	}
+
+	return res
+}
+
+func headerOutputFrom(h object.Header) headerOutput {
+	switch t := h.Value.(type) {
+	case *object.Header_Link:
+		return headerOutput{Type: "Link", Value: map[string]string{
+			"type": t.Link.Type.String(),
+			"id":   t.Link.ID.String(),
+		}}
+	case *object.Header_Redirect:
+		return headerOutput{Type: "Redirect", Value: map[string]string{
+			"cid": t.Redirect.CID.String(),
+			"oid": t.Redirect.ObjectID.String(),
+		}}
+	case *object.Header_UserHeader:
+		return headerOutput{Type: "UserHeader", Value: map[string]string{
+			"key":   t.UserHeader.Key,
+			"value": t.UserHeader.Value,
+		}}
+	case *object.Header_Transform:
+		return headerOutput{Type: "Transform", Value: map[string]string{
+			"type": t.Transform.Type.String(),
+		}}
+	case *object.Header_Tombstone:
+		return headerOutput{Type: "Tombstone", Value: map[string]string{}}
+	case *object.Header_HomoHash:
+		return headerOutput{Type: "HomoHash", Value: map[string]string{
+			"hash": hex.EncodeToString(t.HomoHash[:]),
+		}}
+	case *object.Header_PayloadChecksum:
+		return headerOutput{Type: "PayloadChecksum", Value: map[string]string{
+			"checksum": hex.EncodeToString(t.PayloadChecksum),
+		}}
+	case *object.Header_Integrity:
+		return headerOutput{Type: "Integrity", Value: map[string]string{
+			"checksum":  hex.EncodeToString(t.Integrity.HeadersChecksum),
+			"signature": hex.EncodeToString(t.Integrity.ChecksumSignature),
+		}}
+	case *object.Header_StorageGroup:
+		val := make(map[string]string)
+		if sg := t.StorageGroup; sg != nil {
+			val["data_size"] = strconv.FormatUint(sg.ValidationDataSize, 10)
+			val["hash"] = hex.EncodeToString(sg.ValidationHash[:])
+
+			if lt := sg.Lifetime; lt != nil {
+				val["lifetime_unit"] = lt.Unit.String()
+				val["lifetime_value"] = strconv.FormatInt(lt.Value, 10)
+			}
+		}
+
+		return headerOutput{Type: "StorageGroup", Value: val}
+	case *object.Header_Token:
+		return headerOutput{Type: "Token", Value: map[string]string{
+			"id":   t.Token.GetID().String(),
+			"verb": t.Token.GetVerb().String(),
+		}}
+	case *object.Header_PublicKey:
+		return headerOutput{Type: "PublicKey", Value: map[string]string{
+			"key": hex.EncodeToString(t.PublicKey.Value),
+		}}
+	default:
+		return headerOutput{Type: fmt.Sprintf("Unknown(%T)", t), Value: map[string]string{}}
+	}
+}
+
+// objectPutOutputFrom returns results of stored items in the order of items.
+func objectPutOutputFrom(items []string, results map[string]*storedObjectOutput) objectPutOutput {
+	res := objectPutOutput{
+		Objects: make([]storedObjectOutput, 0, len(results)),
+	}
+
+	for i := range items {
+		if r, ok := results[items[i]]; ok {
+			res.Objects = append(res.Objects, *r)
+		}
+	}
+
+	return res
+}
+
+// objectGetOutputFrom returns objects that were successfully
+// written into the files.
+func objectGetOutputFrom(cid refs.CID, oids, fPaths []string, errs []error) objectGetOutput {
+	res := objectGetOutput{
+		Objects: make([]fetchedObjectOutput, 0, len(oids)),
+	}
+
+	for i := range oids {
+		if errs[i] == nil {
+			res.Objects = append(res.Objects, fetchedObjectOutput{
+				CID:  cid.String(),
+				OID:  oids[i],
+				File: fPaths[i],
+			})
+		}
+	}
+
+	return res
+}
+
+func searchOutputFrom(addrs []refs.Address) searchOutput {
+	res := searchOutput{
+		Objects: make([]addressOutput, 0, len(addrs)),
+	}
+
+	for i := range addrs {
+		res.Objects = append(res.Objects, addressOutput{
+			CID: addrs[i].CID.String(),
+			OID: addrs[i].ObjectID.String(),
+		})
+	}
+
+	return res
+}
+
+func balanceOutputFrom(resp *accounting.BalanceResponse) balanceOutput {
+	var (
+		balance = decimal.Zero.Copy()
+		locked  = decimal.Zero.Copy()
+	)
+
+	if resp.GetBalance() != nil {
+		balance = resp.GetBalance()
+	}
+
+	if resp != nil && len(resp.LockAccounts) > 0 {
+		locked = accounting.SumFunds(resp.LockAccounts)
+	}
+
+	res := balanceOutput{
+		Active: balance.String(),
+		Locked: locked.String(),
+		Locks:  make([]lockOutput, 0),
+	}
+
+	if resp == nil {
+		return res
+	}
+
+	for _, lf := range resp.LockAccounts {
+		res.Locks = append(res.Locks, lockOutput{
+			Amount:   lf.ActiveFunds.String(),
+			Target:   lf.LockTarget.String(),
+			Lifetime: lf.Lifetime.Value,
+			Unit:     lf.Lifetime.Unit.String(),
+		})
+	}
+
+	return res
+}
+
+func withdrawalOutputFrom(data []byte) (*withdrawalOutput, error) {
+	ch := new(accounting.Cheque)
+	if err := ch.UnmarshalBinary(data); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal cheque")
+	}
+
+	res := &withdrawalOutput{
+		ID:         ch.ID.String(),
+		OwnerID:    ch.Owner.String(),
+		Amount:     ch.Amount.String(),
+		Height:     ch.Height,
+		Signatures: make([]signatureOutput, 0, len(ch.Signatures)),
+		Cheque:     hex.EncodeToString(data),
+	}
+
+	for i := range ch.Signatures {
+		res.Signatures = append(res.Signatures, signatureOutput{
+			Hash: hex.EncodeToString(ch.Signatures[i].Hash),
+			Key:  base58.Encode(crypto.MarshalPublicKey(ch.Signatures[i].Key)),
+		})
+	}
+
+	return res, nil
+}
+
+func bearerOutputFrom(token *service.BearerTokenMsg) (*bearerOutput, error) {
+	rules, err := eaclRulesFrom(token.GetACLRules())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &bearerOutput{
+		OwnerID:         token.GetOwnerID().String(),
+		OwnerKey:        hex.EncodeToString(token.GetOwnerKey()),
+		ExpirationEpoch: token.ExpirationEpoch(),
+		Rules:           rules,
+		Valid:           true,
+	}
+
+	if err := verifyBearerToken(token); err != nil {
+		res.Valid = false
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+func netmapOutputFrom(nm *bootstrap.SpreadMap) netmapOutput {
+	res := netmapOutput{
+		Epoch: nm.Epoch,
+		Nodes: make([]nodeOutput, 0, len(nm.NetMap)),
+	}
+
+	for i := range nm.NetMap {
+		res.Nodes = append(res.Nodes, nodeOutput{
+			Address:   nm.NetMap[i].Address,
+			PublicKey: hex.EncodeToString(nm.NetMap[i].PubKey),
+			Options:   nm.NetMap[i].Options,
+			Status:    uint64(nm.NetMap[i].Status),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/accounting"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func Test_writeOutput(t *testing.T) {
+	var oid refs.ObjectID
+
+	require.NoError(t, oid.Parse("7e0b9c6c-aabc-4985-949e-2680e577b48b"))
+
+	out := searchOutputFrom([]refs.Address{{ObjectID: oid, CID: refs.CID{}}})
+
+	tests := []struct {
+		format string
+		result string
+	}{
+		{
+			format: outputText,
+			result: "text\n",
+		},
+		{
+			format: outputJSON,
+			result: `{
+	"objects": [
+		{
+			"cid": "11111111111111111111111111111111",
+			"oid": "7e0b9c6c-aabc-4985-949e-2680e577b48b"
+		}
+	]
+}
+`,
+		},
+		{
+			format: outputYAML,
+			result: `objects:
+- cid: "11111111111111111111111111111111"
+  oid: 7e0b9c6c-aabc-4985-949e-2680e577b48b
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+
+			require.NoError(t, writeOutput(buf, tt.format, out, func(w io.Writer) error {
+				_, err := w.Write([]byte("text\n"))
+				return err
+			}))
+			require.Equal(t, tt.result, buf.String())
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		require.Error(t, writeOutput(new(bytes.Buffer), "xml", out, nil))
+	})
+}
+
+func Test_messageWriter(t *testing.T) {
+	flags := []cli.Flag{outputFormat}
+
+	require.Equal(t, os.Stdout, messageWriter(newTestContext(t, flags)))
+	require.Equal(t, os.Stdout, messageWriter(newTestContext(t, flags, "--output", outputText)))
+	require.Equal(t, os.Stderr, messageWriter(newTestContext(t, flags, "--output", outputJSON)))
+	require.Equal(t, os.Stderr, messageWriter(newTestContext(t, flags, "--output", outputYAML)))
+}
+
+func Test_objectPutOutputFrom(t *testing.T) {
+	results := map[string]*storedObjectOutput{
+		"b": {File: "b", OID: "2"},
+		"a": {File: "a", OID: "1", Parts: 2},
+	}
+
+	out := objectPutOutputFrom([]string{"a", "failed", "b"}, results)
+	require.Equal(t, []storedObjectOutput{
+		{File: "a", OID: "1", Parts: 2},
+		{File: "b", OID: "2"},
+	}, out.Objects)
+
+	out = objectPutOutputFrom([]string{"failed"}, results)
+	require.NotNil(t, out.Objects)
+	require.Empty(t, out.Objects)
+}
+
+func Test_objectGetOutputFrom(t *testing.T) {
+	out := objectGetOutputFrom(refs.CID{}, []string{"a", "b"}, []string{"dir/a", "dir/b"}, []error{nil, errors.New("failed")})
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, writeOutput(buf, outputJSON, out, nil))
+	require.JSONEq(t, `{"objects": [{"cid": "11111111111111111111111111111111", "oid": "a", "file": "dir/a"}]}`, buf.String())
+}
+
+func Test_objectOutputFrom(t *testing.T) {
+	var oid refs.ObjectID
+
+	require.NoError(t, oid.Parse("7e0b9c6c-aabc-4985-949e-2680e577b48b"))
+
+	obj := &object.Object{
+		SystemHeader: object.SystemHeader{
+			Version:       1,
+			PayloadLength: 3,
+			ID:            oid,
+			CreatedAt: object.CreationPoint{
+				UnixTime: 1,
+				Epoch:    2,
+			},
+		},
+		Headers: parseUserHeaders([]string{"key=value"}),
+		Payload: []byte{1, 2, 3},
+	}
+
+	out := objectOutputFrom(obj)
+
+	require.Equal(t, oid.String(), out.SystemHeader.ID)
+	require.Equal(t, uint64(3), out.SystemHeader.PayloadLength)
+	require.Equal(t, int64(1), out.SystemHeader.CreatedUnix)
+	require.Equal(t, uint64(2), out.SystemHeader.CreatedEpoch)
+	require.Equal(t, "010203", out.Payload)
+	require.Equal(t, []headerOutput{{
+		Type:  "UserHeader",
+		Value: map[string]string{"key": "key", "value": "value"},
+	}}, out.Headers)
+}
+
+func Test_balanceOutputFrom(t *testing.T) {
+	target := &accounting.LockTarget{
+		Target: &accounting.LockTarget_WithdrawTarget{
+			WithdrawTarget: &accounting.WithdrawTarget{
+				Cheque: "cheque",
+			},
+		},
+	}
+
+	out := balanceOutputFrom(mockedBalance(target, 100, 123, 456))
+
+	require.Equal(t, "0.000001", out.Active)
+	require.Equal(t, "0.00000579", out.Locked)
+	require.Len(t, out.Locks, 2)
+	require.Equal(t, "0.00000123", out.Locks[0].Amount)
+	require.Equal(t, "NeoBlock", out.Locks[0].Unit)
+
+	out = balanceOutputFrom(nil)
+	require.Equal(t, "0", out.Active)
+	require.Empty(t, out.Locks)
+}
+
+func Test_withdrawalOutputFrom(t *testing.T) {
+	out, err := withdrawalOutputFrom(mockedCheque(t))
+	require.NoError(t, err)
+
+	require.Equal(t, "NQHKh7fKGieCPrPuiEkY58ucRFwWMyU1Mc", out.OwnerID)
+	require.Equal(t, "100.7", out.Amount)
+	require.Equal(t, uint64(100), out.Height)
+	require.Len(t, out.Signatures, 4)
+
+	_, err = withdrawalOutputFrom(nil)
+	require.Error(t, err)
+}
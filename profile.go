@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	profileAction = &action{}
+
+	listProfilesAction = &action{
+		Action: listProfiles,
+	}
+
+	useProfileAction = &action{
+		Action: useProfile,
+	}
+
+	showProfileAction = &action{
+		Action: showProfile,
+	}
+
+	deleteProfileAction = &action{
+		Action: deleteProfile,
+	}
+)
+
+// hiddenKey replaces inline private keys in profile output.
+const hiddenKey = "<hidden>"
+
+// profileNames returns sorted names of profiles stored in config file.
+func profileNames() []string {
+	profiles := viper.GetStringMap(ProfilesCfgValue)
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func profileOutputFrom(name string, current bool, v *viper.Viper) profileOutput {
+	res := profileOutput{
+		Name:        name,
+		Current:     current,
+		Host:        v.GetString(HostCfgValue),
+		Key:         v.GetString(KeyCfgValue),
+		TTL:         v.GetUint(TTLCfgValue),
+		XHeaders:    v.GetStringSlice(XHeadersCfgValue),
+		DialTimeout: v.GetString(DialTimeoutCfgValue),
+		Timeout:     v.GetString(TimeoutCfgValue),
+
+		TLS:                v.GetBool(TLSCfgValue),
+		CACert:             v.GetString(CACertCfgValue),
+		ClientCert:         v.GetString(ClientCertCfgValue),
+		ClientKey:          v.GetString(ClientKeyCfgValue),
+		InsecureSkipVerify: v.GetBool(InsecureSkipVerifyCfgValue),
+	}
+
+	if res.XHeaders == nil {
+		res.XHeaders = make([]string, 0)
+	}
+
+	// do not print private keys, only paths to key files
+	if res.Key != "" {
+		if _, err := os.Stat(res.Key); err != nil {
+			res.Key = hiddenKey
+		}
+	}
+
+	return res
+}
+
+func listProfiles(c *cli.Context) error {
+	out := profileListOutput{
+		Current:  strings.ToLower(viper.GetString(ProfileCfgValue)),
+		Profiles: profileNames(),
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		for _, name := range out.Profiles {
+			mark := " "
+			if name == out.Current {
+				mark = "*"
+			}
+
+			if _, err := fmt.Fprintf(w, "%s %s\n", mark, name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func useProfile(c *cli.Context) error {
+	name := c.Args().First()
+	if name == "" {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	} else if !viper.IsSet(profileKey(name)) {
+		return errors.Errorf("profile %q not found", name)
+	}
+
+	viper.Set(ProfileCfgValue, strings.ToLower(name))
+
+	if err := viper.WriteConfig(); err != nil {
+		return errors.Wrap(err, "could not write config")
+	}
+
+	out := profileUseOutput{Current: strings.ToLower(name)}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "switched to profile %q\n", name)
+		return err
+	})
+}
+
+func showProfile(c *cli.Context) error {
+	name := c.Args().First()
+	if name == "" {
+		name = profileName(c)
+	}
+
+	if name == "" {
+		return errors.New("profile is not selected")
+	}
+
+	v := viper.Sub(profileKey(name))
+	if v == nil {
+		return errors.Errorf("profile %q not found", name)
+	}
+
+	name = strings.ToLower(name)
+	out := profileOutputFrom(name, name == strings.ToLower(viper.GetString(ProfileCfgValue)), v)
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Profile: %s\nCurrent: %t\nHost: %s\nKey: %s\nTTL: %d\nRequest headers: %s\nDial timeout: %s\nTimeout: %s\n"+
+			"TLS: %t\nCA certificate: %s\nClient certificate: %s\nClient key: %s\nInsecure skip verify: %t\n",
+			out.Name, out.Current, out.Host, out.Key, out.TTL, strings.Join(out.XHeaders, ", "), out.DialTimeout, out.Timeout,
+			out.TLS, out.CACert, out.ClientCert, out.ClientKey, out.InsecureSkipVerify)
+
+		return err
+	})
+}
+
+func deleteProfile(c *cli.Context) error {
+	name := c.Args().First()
+	if name == "" {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	} else if !viper.IsSet(profileKey(name)) {
+		return errors.Errorf("profile %q not found", name)
+	}
+
+	var (
+		prefix  = strings.ToLower(profileKey(name)) + "."
+		current = strings.EqualFold(viper.GetString(ProfileCfgValue), name)
+		cfg     = viper.New()
+	)
+
+	// viper can't unset keys, so config is rewritten without the profile
+	for _, key := range viper.AllKeys() {
+		if strings.HasPrefix(key, prefix) || (current && key == ProfileCfgValue) {
+			continue
+		}
+
+		cfg.Set(key, viper.Get(key))
+	}
+
+	cfg.SetConfigType("yml")
+
+	if err := cfg.WriteConfigAs(viper.ConfigFileUsed()); err != nil {
+		return errors.Wrap(err, "could not write config")
+	}
+
+	out := profileDeleteOutput{Deleted: strings.ToLower(name)}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "profile %q deleted\n", name)
+		return err
+	})
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func Test_profileOutputFrom(t *testing.T) {
+	cfg := `profiles:
+  dev:
+    host: 127.0.0.1:8080
+    key: 1111111111111111111111111111111111111111111111111111111111111111
+    ttl: 5
+    xhdr:
+    - key=value
+    dial-timeout: 5s
+  stage:
+    host: 127.0.0.1:9090
+    key: ./profile_test.go
+`
+
+	v := viper.New()
+	v.SetConfigType("yml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(cfg)))
+
+	out := profileOutputFrom("dev", true, v.Sub("profiles.dev"))
+	require.Equal(t, profileOutput{
+		Name:        "dev",
+		Current:     true,
+		Host:        "127.0.0.1:8080",
+		Key:         hiddenKey,
+		TTL:         5,
+		XHeaders:    []string{"key=value"},
+		DialTimeout: "5s",
+	}, out)
+
+	out = profileOutputFrom("stage", false, v.Sub("profiles.stage"))
+	require.Equal(t, "./profile_test.go", out.Key)
+	require.Empty(t, out.XHeaders)
+	require.NotNil(t, out.XHeaders)
+}
+
+func Test_changeProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profile")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	cfgPath := filepath.Join(dir, "config.yml")
+	require.NoError(t, ioutil.WriteFile(cfgPath, []byte(`profiles:
+  dev:
+    host: 127.0.0.1:8080
+  stage:
+    host: 127.0.0.1:9090
+`), 0600))
+
+	viper.SetConfigFile(cfgPath)
+	require.NoError(t, viper.ReadInConfig())
+
+	defer viper.Reset()
+
+	newContext := func(args ...string) *cli.Context {
+		return newTestContext(t, []cli.Flag{outputFormat}, append([]string{"--output", outputJSON}, args...)...)
+	}
+
+	res := captureStdout(t, func() {
+		require.NoError(t, useProfile(newContext("Dev")))
+	})
+	require.JSONEq(t, `{"current": "dev"}`, res)
+	require.Equal(t, "dev", viper.GetString(ProfileCfgValue))
+
+	res = captureStdout(t, func() {
+		require.NoError(t, deleteProfile(newContext("stage")))
+	})
+	require.JSONEq(t, `{"deleted": "stage"}`, res)
+
+	require.NoError(t, viper.ReadInConfig())
+	require.Equal(t, []string{"dev"}, profileNames())
+}
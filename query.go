@@ -0,0 +1,489 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/nspcc-dev/neofs-api-go/query"
+	"github.com/pkg/errors"
+)
+
+// Search query language.
+//
+// Query consists of conditions combined with && and ||, grouped by
+// parentheses and negated by !:
+//
+//	FileName == "a.txt" && (Size > 1024 || Size == 0) && exists(Nicename)
+//
+// Supported operators are ==, !=, =~ (regular expression), >, >=, <, <=
+// (non-negative integers) and exists(<header>). Search service accepts
+// only a conjunction of exact and regex filters, so every condition is
+// translated into such filter and || is allowed only between conditions
+// on the same header.
+
+type (
+	queryToken struct {
+		kind string
+		val  string
+		pos  int
+	}
+
+	queryTerm struct {
+		name  string
+		op    string
+		value string
+
+		// alts is set for disjunction of conditions on the same header
+		alts []queryTerm
+	}
+
+	queryParser struct {
+		tokens []queryToken
+		pos    int
+	}
+)
+
+const (
+	tokWord   = "word"
+	tokString = "string"
+	tokOp     = "operator"
+	tokEOF    = "end of query"
+
+	opEq     = "=="
+	opNe     = "!="
+	opRegex  = "=~"
+	opGt     = ">"
+	opGe     = ">="
+	opLt     = "<"
+	opLe     = "<="
+	opExists = "exists"
+	opOr     = "||"
+)
+
+var queryOperators = []string{"&&", "||", "==", "!=", "=~", "!~", ">=", "<=", ">", "<", "!", "(", ")"}
+
+// parseSearchQuery translates query expression into search filters.
+func parseSearchQuery(s string) ([]query.Filter, error) {
+	tokens, err := lexQuery(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{tokens: tokens}
+
+	terms, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	} else if tok := p.peek(); tok.kind != tokEOF {
+		return nil, errors.Errorf("unexpected %q at position %d", tok.val, tok.pos)
+	}
+
+	filters := make([]query.Filter, 0, len(terms))
+
+	for i := range terms {
+		f, err := terms[i].filter()
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, f)
+	}
+
+	return filters, nil
+}
+
+func lexQuery(s string) ([]queryToken, error) {
+	var (
+		tokens []queryToken
+		rs     = []rune(s)
+	)
+
+loop:
+	for i := 0; i < len(rs); {
+		switch {
+		case unicode.IsSpace(rs[i]):
+			i++
+			continue loop
+		case rs[i] == '"':
+			j := i + 1
+			for ; j < len(rs) && rs[j] != '"'; j++ {
+				if rs[j] == '\\' {
+					j++
+				}
+			}
+
+			if j >= len(rs) {
+				return nil, errors.Errorf("unterminated string at position %d", i)
+			}
+
+			val, err := strconv.Unquote(string(rs[i : j+1]))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid string at position %d", i)
+			}
+
+			tokens = append(tokens, queryToken{kind: tokString, val: val, pos: i})
+			i = j + 1
+
+			continue loop
+		}
+
+		for _, op := range queryOperators {
+			if strings.HasPrefix(string(rs[i:]), op) {
+				tokens = append(tokens, queryToken{kind: tokOp, val: op, pos: i})
+				i += len([]rune(op))
+
+				continue loop
+			}
+		}
+
+		j := i
+		for ; j < len(rs) && !unicode.IsSpace(rs[j]) && !strings.ContainsRune(`()&|=!<>"`, rs[j]); j++ {
+		}
+
+		if j == i {
+			return nil, errors.Errorf("unexpected %q at position %d", rs[i], i)
+		}
+
+		tokens = append(tokens, queryToken{kind: tokWord, val: string(rs[i:j]), pos: i})
+		i = j
+	}
+
+	return append(tokens, queryToken{kind: tokEOF, pos: len(rs)}), nil
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *queryParser) expect(val string) error {
+	if tok := p.next(); tok.kind != tokOp || tok.val != val {
+		return errors.Errorf("expected %q at position %d", val, tok.pos)
+	}
+
+	return nil
+}
+
+func (p *queryParser) parseOr() ([]queryTerm, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for tok := p.peek(); tok.kind == tokOp && tok.val == "||"; tok = p.peek() {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(left) != 1 || len(right) != 1 || left[0].name != right[0].name {
+			return nil, errors.Errorf("|| at position %d is supported only between conditions on the same header", tok.pos)
+		}
+
+		left = []queryTerm{{
+			name: left[0].name,
+			op:   opOr,
+			alts: append(left[0].disjuncts(), right[0].disjuncts()...),
+		}}
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() ([]queryTerm, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for tok := p.peek(); tok.kind == tokOp && tok.val == "&&"; tok = p.peek() {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = append(left, right...)
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) parseUnary() ([]queryTerm, error) {
+	tok := p.peek()
+
+	switch {
+	case tok.kind == tokOp && tok.val == "!":
+		p.next()
+
+		terms, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		} else if len(terms) != 1 {
+			return nil, errors.Errorf("negation at position %d is supported only for single condition", tok.pos)
+		}
+
+		term, err := terms[0].negate()
+		if err != nil {
+			return nil, errors.Wrapf(err, "negation at position %d", tok.pos)
+		}
+
+		return []queryTerm{term}, nil
+	case tok.kind == tokOp && tok.val == "(":
+		p.next()
+
+		terms, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		return terms, p.expect(")")
+	default:
+		term, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+
+		return []queryTerm{term}, nil
+	}
+}
+
+func (p *queryParser) parseCondition() (queryTerm, error) {
+	name := p.next()
+	if name.kind != tokWord && name.kind != tokString {
+		return queryTerm{}, errors.Errorf("expected header name at position %d", name.pos)
+	}
+
+	if name.kind == tokWord && name.val == opExists && p.peek().val == "(" {
+		p.next()
+
+		hdr := p.next()
+		if hdr.kind != tokWord && hdr.kind != tokString {
+			return queryTerm{}, errors.Errorf("expected header name at position %d", hdr.pos)
+		}
+
+		return queryTerm{name: hdr.val, op: opExists}, p.expect(")")
+	}
+
+	op := p.next()
+	if op.kind != tokOp {
+		return queryTerm{}, errors.Errorf("expected operator at position %d", op.pos)
+	}
+
+	switch op.val {
+	case opEq, opNe, opRegex, opGt, opGe, opLt, opLe:
+	case "!~":
+		return queryTerm{}, errors.Errorf("operator !~ at position %d is not supported", op.pos)
+	default:
+		return queryTerm{}, errors.Errorf("unexpected operator %q at position %d", op.val, op.pos)
+	}
+
+	val := p.next()
+	if val.kind != tokWord && val.kind != tokString {
+		return queryTerm{}, errors.Errorf("expected value at position %d", val.pos)
+	}
+
+	term := queryTerm{name: name.val, op: op.val, value: val.val}
+
+	// check the value early to report its position
+	if _, err := term.pattern(); err != nil {
+		return queryTerm{}, errors.Wrapf(err, "invalid condition at position %d", name.pos)
+	}
+
+	return term, nil
+}
+
+func (t queryTerm) disjuncts() []queryTerm {
+	if t.op == opOr {
+		return t.alts
+	}
+
+	return []queryTerm{t}
+}
+
+func (t queryTerm) negate() (queryTerm, error) {
+	negated := map[string]string{
+		opEq: opNe,
+		opNe: opEq,
+		opGt: opLe,
+		opGe: opLt,
+		opLt: opGe,
+		opLe: opGt,
+	}
+
+	op, ok := negated[t.op]
+	if !ok {
+		return queryTerm{}, errors.Errorf("can't negate %q condition", t.op)
+	}
+
+	t.op = op
+
+	return t, nil
+}
+
+// filter converts term into search filter.
+func (t queryTerm) filter() (query.Filter, error) {
+	if t.op == opEq {
+		return query.Filter{
+			Type:  query.Filter_Exact,
+			Name:  t.name,
+			Value: t.value,
+		}, nil
+	}
+
+	pattern, err := t.pattern()
+	if err != nil {
+		return query.Filter{}, err
+	}
+
+	return query.Filter{
+		Type:  query.Filter_Regex,
+		Name:  t.name,
+		Value: "^(?:" + pattern + ")$",
+	}, nil
+}
+
+// pattern returns regular expression that matches the whole header value
+// satisfying the term.
+func (t queryTerm) pattern() (string, error) {
+	switch t.op {
+	case opEq:
+		return regexp.QuoteMeta(t.value), nil
+	case opNe:
+		return notEqualPattern(t.value), nil
+	case opRegex:
+		if _, err := regexp.Compile(t.value); err != nil {
+			return "", err
+		}
+
+		return "(?s:.*)(?:" + t.value + ")(?s:.*)", nil
+	case opExists:
+		return "(?s:.*)", nil
+	case opGt, opGe, opLt, opLe:
+		n, err := strconv.ParseUint(t.value, 10, 64)
+		if err != nil {
+			return "", errors.Errorf("numeric comparison requires non-negative integer, got %q", t.value)
+		}
+
+		return numericPattern(t.op, strconv.FormatUint(n, 10))
+	case opOr:
+		items := make([]string, 0, len(t.alts))
+
+		for i := range t.alts {
+			item, err := t.alts[i].pattern()
+			if err != nil {
+				return "", err
+			}
+
+			items = append(items, item)
+		}
+
+		return strings.Join(items, "|"), nil
+	default:
+		return "", errors.Errorf("unsupported operator %q", t.op)
+	}
+}
+
+// notEqualPattern returns regular expression that matches
+// any string except v.
+func notEqualPattern(v string) string {
+	var (
+		rs    = []rune(v)
+		items = make([]string, 0, 2*len(rs)+1)
+	)
+
+	for k := range rs {
+		prefix := regexp.QuoteMeta(string(rs[:k]))
+
+		// proper prefix of v
+		items = append(items, prefix)
+
+		// string that differs from v at position k
+		items = append(items, fmt.Sprintf(`%s[^\x{%x}](?s:.*)`, prefix, rs[k]))
+	}
+
+	// v with a suffix
+	items = append(items, regexp.QuoteMeta(v)+"(?s:.+)")
+
+	return strings.Join(items, "|")
+}
+
+// numericPattern returns regular expression that matches decimal
+// representation of non-negative integers satisfying comparison with n.
+func numericPattern(op, n string) (string, error) {
+	var items []string
+
+	switch op {
+	case opGt, opGe:
+		// numbers with more digits
+		items = append(items, fmt.Sprintf("[1-9][0-9]{%d,}", len(n)))
+
+		// numbers with the same length that are greater at position k
+		for k := 0; k < len(n); k++ {
+			if n[k] == '9' {
+				continue
+			}
+
+			items = append(items, n[:k]+digitRange(n[k]+1, '9')+digitsPattern(len(n)-k-1))
+		}
+	case opLt, opLe:
+		// numbers with less digits
+		if len(n) > 1 {
+			items = append(items, "0", "[1-9][0-9]{0,"+strconv.Itoa(len(n)-2)+"}")
+		}
+
+		// numbers with the same length that are less at position k
+		for k := 0; k < len(n); k++ {
+			low := byte('0')
+			if k == 0 && len(n) > 1 {
+				low = '1'
+			}
+
+			if n[k] <= low {
+				continue
+			}
+
+			items = append(items, n[:k]+digitRange(low, n[k]-1)+digitsPattern(len(n)-k-1))
+		}
+	}
+
+	if op == opGe || op == opLe {
+		items = append(items, n)
+	}
+
+	if len(items) == 0 {
+		return "", errors.Errorf("condition %s %s can't be satisfied", op, n)
+	}
+
+	return strings.Join(items, "|"), nil
+}
+
+func digitRange(from, to byte) string {
+	if from == to {
+		return string(from)
+	}
+
+	return "[" + string(from) + "-" + string(to) + "]"
+}
+
+func digitsPattern(n int) string {
+	if n == 0 {
+		return ""
+	}
+
+	return "[0-9]{" + strconv.Itoa(n) + "}"
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/query"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSearchQuery(t *testing.T) {
+	t.Run("valid queries", func(t *testing.T) {
+		filters, err := parseSearchQuery(`FileName == "a b.txt" && Nicename =~ cat && exists(Owner)`)
+		require.NoError(t, err)
+		require.Len(t, filters, 3)
+
+		require.Equal(t, query.Filter{
+			Type:  query.Filter_Exact,
+			Name:  "FileName",
+			Value: "a b.txt",
+		}, filters[0])
+
+		require.Equal(t, query.Filter_Regex, filters[1].Type)
+		require.Equal(t, "Nicename", filters[1].Name)
+		require.Regexp(t, filters[1].Value, "black cat")
+		require.NotRegexp(t, filters[1].Value, "dog")
+
+		require.Equal(t, query.Filter_Regex, filters[2].Type)
+		require.Regexp(t, filters[2].Value, "")
+	})
+
+	t.Run("disjunction on the same header", func(t *testing.T) {
+		filters, err := parseSearchQuery(`(Size > 1024 || Size == 0 || Size == "5") && Name != x`)
+		require.NoError(t, err)
+		require.Len(t, filters, 2)
+
+		for _, v := range []string{"0", "5", "1025", "99999"} {
+			require.Regexp(t, filters[0].Value, v)
+		}
+
+		for _, v := range []string{"1", "1024", "500"} {
+			require.NotRegexp(t, filters[0].Value, v)
+		}
+	})
+
+	t.Run("negation", func(t *testing.T) {
+		filters, err := parseSearchQuery(`!(Size >= 10) && !(Name == x)`)
+		require.NoError(t, err)
+		require.Len(t, filters, 2)
+
+		require.Regexp(t, filters[0].Value, "9")
+		require.NotRegexp(t, filters[0].Value, "10")
+		require.Regexp(t, filters[1].Value, "y")
+		require.NotRegexp(t, filters[1].Value, "x")
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		for _, q := range []string{
+			"",
+			"Name",
+			"Name ==",
+			"== x",
+			`Name == "x`,
+			"Name == x || Size == 1",
+			"Name == x Size == 1",
+			"(Name == x",
+			"Name !~ x",
+			"Name =~ (",
+			"Size > -1",
+			"Size > 1.5",
+			"Size < 0",
+			"!exists(Name)",
+			"!(Name == x && Size == 1)",
+			"Name & x",
+		} {
+			_, err := parseSearchQuery(q)
+			require.Error(t, err, q)
+		}
+	})
+}
+
+func Test_notEqualPattern(t *testing.T) {
+	for _, v := range []string{"", "a", "abc", "a.c", "ж"} {
+		re := regexp.MustCompile("^(?:" + notEqualPattern(v) + ")$")
+
+		require.False(t, re.MatchString(v), v)
+
+		for _, s := range []string{"", "a", "ab", "abc", "abcd", "abd", "xbc", "a.c", "axc", "ж", "жж", "\n"} {
+			require.Equal(t, s != v, re.MatchString(s), "%q != %q", s, v)
+		}
+	}
+}
+
+func Test_numericPattern(t *testing.T) {
+	cmp := map[string]func(a, b uint64) bool{
+		opGt: func(a, b uint64) bool { return a > b },
+		opGe: func(a, b uint64) bool { return a >= b },
+		opLt: func(a, b uint64) bool { return a < b },
+		opLe: func(a, b uint64) bool { return a <= b },
+	}
+
+	for op, fn := range cmp {
+		for _, n := range []uint64{0, 1, 9, 10, 19, 90, 99, 100, 109, 1024} {
+			pattern, err := numericPattern(op, strconv.FormatUint(n, 10))
+			if op == opLt && n == 0 {
+				require.Error(t, err)
+				continue
+			}
+
+			require.NoError(t, err)
+
+			re := regexp.MustCompile("^(?:" + pattern + ")$")
+
+			for v := uint64(0); v < 2000; v++ {
+				require.Equal(t, fn(v, n), re.MatchString(strconv.FormatUint(v, 10)), "%d %s %d", v, op, n)
+			}
+		}
+	}
+}
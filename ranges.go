@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/pkg/errors"
+)
+
+const (
+	rangeFormatRaw    = "raw"
+	rangeFormatHex    = "hex"
+	rangeFormatBase64 = "base64"
+)
+
+// rangeSpec is a payload range from command line,
+// which may require payload length to be resolved.
+type rangeSpec struct {
+	offset, length uint64
+
+	// suffix range contains last length bytes of the payload
+	suffix bool
+
+	// open range lasts from offset till the end of the payload
+	open bool
+}
+
+// parseRangeSpec parses payload range in one of the forms:
+// offset:length, offset: (till the end of the payload)
+// or -length (last bytes of the payload).
+func parseRangeSpec(s string) (rng rangeSpec, err error) {
+	if strings.HasPrefix(s, "-") {
+		rng.suffix = true
+
+		if rng.length, err = strconv.ParseUint(s[1:], 10, 64); err != nil {
+			return rng, errors.Wrapf(err, "can't parse suffix length of range %q", s)
+		}
+
+		return rng, nil
+	}
+
+	items := strings.Split(s, ":")
+	if len(items) != 2 {
+		return rng, errors.Errorf("range %q must have form 'offset:length', 'offset:' or '-length'", s)
+	}
+
+	if rng.offset, err = strconv.ParseUint(items[0], 10, 64); err != nil {
+		return rng, errors.Wrapf(err, "can't parse offset of range %q", s)
+	}
+
+	if items[1] == "" {
+		rng.open = true
+	} else if rng.length, err = strconv.ParseUint(items[1], 10, 64); err != nil {
+		return rng, errors.Wrapf(err, "can't parse length of range %q", s)
+	}
+
+	return rng, nil
+}
+
+func parseRangeSpecs(args []string) ([]rangeSpec, error) {
+	res := make([]rangeSpec, 0, len(args))
+
+	for i := range args {
+		rng, err := parseRangeSpec(args[i])
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, rng)
+	}
+
+	return res, nil
+}
+
+// needSize checks if any of ranges can't be resolved without payload length.
+func needSize(specs []rangeSpec) bool {
+	for i := range specs {
+		if specs[i].suffix || specs[i].open {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveRanges converts range specs into payload ranges of the object
+// with the payload length.
+func resolveRanges(specs []rangeSpec, size uint64) ([]object.Range, error) {
+	res := make([]object.Range, 0, len(specs))
+
+	for _, spec := range specs {
+		rng := object.Range{Offset: spec.offset, Length: spec.length}
+
+		switch {
+		case spec.suffix:
+			if spec.length > size {
+				return nil, errors.Errorf("suffix length %d exceeds payload length %d", spec.length, size)
+			}
+
+			rng.Offset = size - spec.length
+		case spec.open:
+			if spec.offset > size {
+				return nil, errors.Errorf("offset %d exceeds payload length %d", spec.offset, size)
+			}
+
+			rng.Length = size - spec.offset
+		}
+
+		if rng.Length == 0 {
+			return nil, errors.Errorf("range %d:%d is empty", rng.Offset, rng.Length)
+		}
+
+		res = append(res, rng)
+	}
+
+	return res, nil
+}
+
+// rangeWriter returns writer that encodes range data in the format
+// and function that completes encoding of the range.
+func rangeWriter(w io.Writer, format string) (io.Writer, func() error, error) {
+	switch format {
+	case rangeFormatRaw:
+		return w, func() error { return nil }, nil
+	case rangeFormatHex:
+		return hex.NewEncoder(w), func() error {
+			_, err := io.WriteString(w, "\n")
+			return err
+		}, nil
+	case rangeFormatBase64:
+		enc := base64.NewEncoder(base64.StdEncoding, w)
+
+		return enc, func() error {
+			if err := enc.Close(); err != nil {
+				return err
+			}
+
+			_, err := io.WriteString(w, "\n")
+
+			return err
+		}, nil
+	default:
+		return nil, nil, errors.Errorf("unknown range format: %q", format)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseRangeSpecs(t *testing.T) {
+	specs, err := parseRangeSpecs([]string{"10:20", "4096:", "-1024"})
+	require.NoError(t, err)
+	require.Equal(t, []rangeSpec{
+		{offset: 10, length: 20},
+		{offset: 4096, open: true},
+		{length: 1024, suffix: true},
+	}, specs)
+	require.True(t, needSize(specs))
+	require.False(t, needSize(specs[:1]))
+
+	for _, arg := range []string{"10", "a:10", "10:b", "-", "-a", "1:2:3", ":10"} {
+		_, err := parseRangeSpecs([]string{arg})
+		require.Error(t, err, arg)
+	}
+}
+
+func Test_resolveRanges(t *testing.T) {
+	specs, err := parseRangeSpecs([]string{"10:20", "4096:", "-1024"})
+	require.NoError(t, err)
+
+	ranges, err := resolveRanges(specs, 10000)
+	require.NoError(t, err)
+	require.Equal(t, []object.Range{
+		{Offset: 10, Length: 20},
+		{Offset: 4096, Length: 5904},
+		{Offset: 8976, Length: 1024},
+	}, ranges)
+
+	for _, arg := range []string{"-10001", "10001:", "10000:", "-0", "10:0"} {
+		specs, err := parseRangeSpecs([]string{arg})
+		require.NoError(t, err)
+
+		_, err = resolveRanges(specs, 10000)
+		require.Error(t, err, arg)
+	}
+}
+
+func Test_rangeWriter(t *testing.T) {
+	data := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	for format, res := range map[string]string{
+		rangeFormatRaw:    string(data),
+		rangeFormatHex:    "deadbeef\n",
+		rangeFormatBase64: "3q2+7w==\n",
+	} {
+		buf := new(bytes.Buffer)
+
+		w, done, err := rangeWriter(buf, format)
+		require.NoError(t, err)
+
+		_, err = w.Write(data[:1])
+		require.NoError(t, err)
+
+		_, err = w.Write(data[1:])
+		require.NoError(t, err)
+
+		require.NoError(t, done())
+		require.Equal(t, res, buf.String(), format)
+	}
+
+	_, _, err := rangeWriter(new(bytes.Buffer), "json")
+	require.Error(t, err)
+}
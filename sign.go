@@ -0,0 +1,70 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha512"
+
+	"github.com/nspcc-dev/neofs-api-go/service"
+)
+
+// signatureSize is a size of signature in the form of uncompressed
+// point: 0x04 prefix followed by R and S of 32 bytes each.
+const signatureSize = 65
+
+// signData signs sha512 hash of data and encodes signature the same way as
+// neofs-crypto does. Library encodes R and S with elliptic.Marshal, that
+// panics on recent Go versions since they are not a point on the curve.
+func signData(key *ecdsa.PrivateKey, data []byte) ([]byte, error) {
+	h := sha512.Sum512(data)
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, signatureSize)
+	sig[0] = 4
+
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(sig[1+32-len(rb):33], rb)
+	copy(sig[signatureSize-len(sb):], sb)
+
+	return sig, nil
+}
+
+// addSignatureWithKey signs data of v and adds the signature with
+// the public key to v, like service.AddSignatureWithKey does.
+func addSignatureWithKey(key *ecdsa.PrivateKey, v service.DataWithSignKeyAccumulator) error {
+	data, err := v.SignedData()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signData(key, data)
+	if err != nil {
+		return err
+	}
+
+	v.AddSignKey(sig, &key.PublicKey)
+
+	return nil
+}
+
+// signRequestData signs request with all its tokens, headers and previous
+// signatures, like service.SignRequestData does.
+func signRequestData(key *ecdsa.PrivateKey, src service.RequestSignedData) error {
+	sigSrc, err := service.GroupSignedPayloads(
+		src,
+		src,
+		service.NewSignedSessionToken(src.GetSessionToken()),
+		service.NewSignedBearerToken(src.GetBearerToken()),
+		service.ExtendedHeadersSignedData(src),
+		service.SignKeyPairsSignedData(src.GetSignKeyPairs()...),
+	)
+	if err != nil {
+		return err
+	}
+
+	return addSignatureWithKey(key, sigSrc)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/service"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_signData(t *testing.T) {
+	key := test.DecodeKey(0)
+	data := []byte("data to sign")
+
+	for i := 0; i < 10; i++ {
+		sig, err := signData(key, data)
+		require.NoError(t, err)
+		require.Len(t, sig, signatureSize)
+		require.NoError(t, crypto.Verify(&key.PublicKey, data, sig))
+	}
+}
+
+func Test_signRequestData(t *testing.T) {
+	req := &object.HeadRequest{FullHeaders: true}
+
+	require.NoError(t, signRequestData(test.DecodeKey(0), req))
+	require.NoError(t, signRequestData(test.DecodeKey(1), req))
+	require.Len(t, req.GetSignKeyPairs(), 2)
+	require.NoError(t, service.VerifyRequestData(req))
+}
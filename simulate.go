@@ -0,0 +1,259 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nspcc-dev/neofs-api-go/bootstrap"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/nspcc-dev/netmap"
+	query "github.com/nspcc-dev/netmap-ql"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	netmapFlag = "netmap"
+
+	capacityOption = "Capacity"
+	priceOption    = "Price"
+)
+
+var simulateContainerAction = &action{
+	Action: simulateContainer,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  ruleFlag,
+			Usage: "container rules",
+		},
+		&cli.StringFlag{
+			Name:  netmapFlag,
+			Usage: "path to the network map saved by `status netmap`",
+		},
+		&cli.StringFlag{
+			Name:  cidFlag,
+			Usage: "container ID to select container nodes for",
+		},
+		&cli.StringFlag{
+			Name:  objFlag,
+			Usage: "object ID to select object nodes for",
+		},
+	},
+}
+
+// readNetmap reads network map saved by `status netmap` command
+// in text (json), json or yaml output format.
+func readNetmap(path string) ([]nodeOutput, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read network map file %s", path)
+	}
+
+	var nm struct {
+		Nodes  []nodeOutput         `json:"nodes"`
+		NetMap []bootstrap.NodeInfo `json:"NetMap"`
+	}
+
+	if err = json.Unmarshal(data, &nm); err != nil {
+		out := netmapOutput{}
+		if err = yaml.Unmarshal(data, &out); err != nil {
+			return nil, errors.Wrapf(err, "could not parse network map file %s", path)
+		}
+
+		nm.Nodes = out.Nodes
+	}
+
+	for i := range nm.NetMap {
+		nm.Nodes = append(nm.Nodes, nodeOutput{
+			Address:   nm.NetMap[i].Address,
+			PublicKey: hex.EncodeToString(nm.NetMap[i].PubKey),
+			Options:   nm.NetMap[i].Options,
+			Status:    uint64(nm.NetMap[i].Status),
+		})
+	}
+
+	if len(nm.Nodes) == 0 {
+		return nil, errors.Errorf("network map %s is empty", path)
+	}
+
+	return nm.Nodes, nil
+}
+
+// nodeOptionValue returns numeric value of /Key:Value node option,
+// e.g. capacity or price of the node.
+func nodeOptionValue(opts []string, key string) uint64 {
+	for _, opt := range opts {
+		for _, prop := range strings.Split(strings.TrimPrefix(opt, netmap.Separator), netmap.Separator) {
+			if kv := strings.SplitN(prop, ":", 2); len(kv) == 2 && kv[0] == key {
+				if v, err := strconv.ParseUint(kv[1], 10, 64); err == nil {
+					return v
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+// buildNetmap builds network map graph the same way as storage nodes do.
+// Node index in the graph is its index in the nodes list.
+func buildNetmap(nodes []nodeOutput) (*netmap.Bucket, error) {
+	root := new(netmap.Bucket)
+
+	for i := range nodes {
+		var (
+			opts = nodes[i].Options
+			node = netmap.Node{
+				N: uint32(i),
+				C: nodeOptionValue(opts, capacityOption),
+				P: nodeOptionValue(opts, priceOption),
+			}
+		)
+
+		if len(opts) == 0 {
+			opts = []string{netmap.Separator}
+		}
+
+		if err := root.AddStrawNode(node, opts...); err != nil {
+			return nil, errors.Wrapf(err, "incorrect options of node %s", nodes[i].Address)
+		}
+	}
+
+	return root, nil
+}
+
+// simulatePlacement selects container nodes with container ID as a pivot and
+// then nodes of the object among them. Object nodes are selected only if
+// object ID is set.
+func simulatePlacement(root *netmap.Bucket, rule *netmap.PlacementRule, cid, oid []byte) (netmap.Nodes, netmap.Nodes, error) {
+	graph := root.FindGraph(cid, rule.SFGroups...)
+	if graph == nil {
+		return nil, nil, errors.New("network map can't satisfy the rule")
+	}
+
+	cnrNodes := graph.Nodelist()
+
+	if oid == nil {
+		return cnrNodes, nil, nil
+	}
+
+	count := rule.ReplFactor
+	if count == 0 || int(count) > len(cnrNodes) {
+		count = uint32(len(cnrNodes))
+	}
+
+	sel := graph.GetSelection([]netmap.Select{{Key: netmap.NodesBucket, Count: count}}, oid)
+	if sel == nil {
+		return nil, nil, errors.New("can't select object nodes in container")
+	}
+
+	return cnrNodes, sel.Nodelist(), nil
+}
+
+func simulateContainer(c *cli.Context) error {
+	var (
+		err    error
+		cid    refs.CID
+		oid    refs.ObjectID
+		rule   *netmap.PlacementRule
+		pivot  []byte
+		objKey []byte
+
+		sRule  = c.String(ruleFlag)
+		nmPath = c.String(netmapFlag)
+		sCID   = c.String(cidFlag)
+		sOID   = c.String(objFlag)
+	)
+
+	if sRule == "" || nmPath == "" || (sOID != "" && sCID == "") {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if rule, err = query.ParseQuery(sRule); err != nil {
+		return errors.Wrapf(err, "placement rule parse failed %s", sRule)
+	}
+
+	if sCID != "" {
+		if cid, err = refs.CIDFromString(sCID); err != nil {
+			return errors.Wrapf(err, "can't parse CID %s", sCID)
+		}
+
+		pivot = cid.Bytes()
+	}
+
+	if sOID != "" {
+		if err = oid.Parse(sOID); err != nil {
+			return errors.Wrapf(err, "can't parse object id '%s'", sOID)
+		}
+
+		objKey = oid.Bytes()
+	}
+
+	nodes, err := readNetmap(nmPath)
+	if err != nil {
+		return err
+	}
+
+	root, err := buildNetmap(nodes)
+	if err != nil {
+		return errors.Wrap(err, "could not build network map")
+	}
+
+	cnrNodes, objNodes, err := simulatePlacement(root, rule, pivot, objKey)
+	if err != nil {
+		return errors.Wrapf(err, "placement rule %s", placementStringify(rule))
+	}
+
+	out := placementOutput{
+		Placement: placementStringify(rule),
+		Container: make([]nodeOutput, 0, len(cnrNodes)),
+	}
+
+	for _, n := range cnrNodes {
+		out.Container = append(out.Container, nodes[n.N])
+	}
+
+	for _, n := range objNodes {
+		out.Object = append(out.Object, nodes[n.N])
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		tw := tabwriter.NewWriter(w, 1, 8, 3, ' ', 0)
+
+		if _, err := fmt.Fprintf(tw, "Placement: %s\n", out.Placement); err != nil {
+			return err
+		} else if err := displayPlacementNodes(tw, "Container nodes", out.Container); err != nil {
+			return err
+		} else if sOID != "" {
+			if err := displayPlacementNodes(tw, "Object nodes", out.Object); err != nil {
+				return err
+			}
+		}
+
+		return tw.Flush()
+	})
+}
+
+func displayPlacementNodes(w io.Writer, title string, nodes []nodeOutput) error {
+	if _, err := fmt.Fprintf(w, "%s (%d):\n", title, len(nodes)); err != nil {
+		return err
+	}
+
+	for i := range nodes {
+		if _, err := fmt.Fprintf(w, "- %s\t%s\t%s\n",
+			nodes[i].Address,
+			nodes[i].PublicKey,
+			strings.Join(nodes[i].Options, " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
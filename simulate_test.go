@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	query "github.com/nspcc-dev/netmap-ql"
+	"github.com/stretchr/testify/require"
+)
+
+func testNetmapNodes() []nodeOutput {
+	nodes := make([]nodeOutput, 0, 8)
+
+	for i, country := range []string{"Russia", "Germany", "Sweden", "Finland"} {
+		for j := 0; j < 2; j++ {
+			nodes = append(nodes, nodeOutput{
+				Address: fmt.Sprintf("/ip4/10.0.%d.%d/tcp/8080", i, j),
+				Options: []string{
+					fmt.Sprintf("/Location:Europe/Country:%s/City:City%d", country, j),
+					fmt.Sprintf("/Capacity:%d", 10*(j+1)),
+					"/Price:1",
+				},
+			})
+		}
+	}
+
+	return nodes
+}
+
+func Test_readNetmap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netmap")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	for name, data := range map[string]string{
+		"text": `{"Epoch":10,"NetMap":[{"address":"/ip4/10.0.0.1/tcp/8080","pubkey":"AQID","options":["/Country:Russia"],"status":0}]}`,
+		"json": `{"epoch":10,"nodes":[{"address":"/ip4/10.0.0.1/tcp/8080","public_key":"010203","options":["/Country:Russia"],"status":0}]}`,
+		"yaml": "epoch: 10\nnodes:\n- address: /ip4/10.0.0.1/tcp/8080\n  public_key: \"010203\"\n  options:\n  - /Country:Russia\n  status: 0\n",
+	} {
+		fPath := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(fPath, []byte(data), 0600))
+
+		nodes, err := readNetmap(fPath)
+		require.NoError(t, err, name)
+		require.Equal(t, []nodeOutput{{
+			Address:   "/ip4/10.0.0.1/tcp/8080",
+			PublicKey: "010203",
+			Options:   []string{"/Country:Russia"},
+		}}, nodes, name)
+	}
+
+	fPath := filepath.Join(dir, "empty")
+	require.NoError(t, ioutil.WriteFile(fPath, []byte(`{"Epoch":10,"NetMap":[]}`), 0600))
+
+	_, err = readNetmap(fPath)
+	require.Error(t, err)
+}
+
+func Test_nodeOptionValue(t *testing.T) {
+	opts := []string{"/Location:Europe/Country:Russia", "/Capacity:40", "/Price:abc"}
+
+	require.Equal(t, uint64(40), nodeOptionValue(opts, capacityOption))
+	require.Equal(t, uint64(0), nodeOptionValue(opts, priceOption))
+	require.Equal(t, uint64(0), nodeOptionValue(nil, capacityOption))
+}
+
+func Test_simulatePlacement(t *testing.T) {
+	nodes := testNetmapNodes()
+
+	root, err := buildNetmap(nodes)
+	require.NoError(t, err)
+
+	cid, err := refs.CIDFromBytes(make([]byte, refs.CIDSize))
+	require.NoError(t, err)
+
+	oid, err := refs.NewObjectID()
+	require.NoError(t, err)
+
+	t.Run("container and object nodes", func(t *testing.T) {
+		rule, err := query.ParseQuery("RF 2 SELECT 3 Country 1 Node FILTER Country NE Sweden")
+		require.NoError(t, err)
+
+		cnrNodes, objNodes, err := simulatePlacement(root, rule, cid.Bytes(), oid.Bytes())
+		require.NoError(t, err)
+		require.Len(t, cnrNodes, 3)
+		require.Len(t, objNodes, 2)
+
+		countries := make(map[string]struct{})
+		for _, n := range cnrNodes {
+			require.NotContains(t, nodes[n.N].Options[0], "Sweden")
+			countries[nodes[n.N].Options[0]] = struct{}{}
+		}
+
+		require.Len(t, countries, 3)
+
+		for _, n := range objNodes {
+			require.Contains(t, cnrNodes, n)
+		}
+
+		// placement is deterministic for the same container and object
+		cnrAgain, objAgain, err := simulatePlacement(root, rule, cid.Bytes(), oid.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, cnrNodes, cnrAgain)
+		require.Equal(t, objNodes, objAgain)
+	})
+
+	t.Run("container only", func(t *testing.T) {
+		rule, err := query.ParseQuery("SELECT 4 Node")
+		require.NoError(t, err)
+
+		cnrNodes, objNodes, err := simulatePlacement(root, rule, cid.Bytes(), nil)
+		require.NoError(t, err)
+		require.Len(t, cnrNodes, 4)
+		require.Nil(t, objNodes)
+	})
+
+	t.Run("unsatisfiable", func(t *testing.T) {
+		for _, sRule := range []string{
+			"SELECT 9 Node",
+			"SELECT 5 Country",
+			"SELECT 1 Node FILTER Country EQ Norway",
+		} {
+			rule, err := query.ParseQuery(sRule)
+			require.NoError(t, err)
+
+			_, _, err = simulatePlacement(root, rule, cid.Bytes(), oid.Bytes())
+			require.Error(t, err, sRule)
+		}
+	})
+}
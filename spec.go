@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/container"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/netmap"
+	query "github.com/nspcc-dev/netmap-ql"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// containerSpec is a declarative description of the container.
+	containerSpec struct {
+		Rule     string          `json:"rule" yaml:"rule"`
+		Capacity uint64          `json:"capacity,omitempty" yaml:"capacity,omitempty"`
+		ACL      string          `json:"acl,omitempty" yaml:"acl,omitempty"`
+		EACL     *eaclRulesTable `json:"eacl,omitempty" yaml:"eacl,omitempty"`
+		Wait     *bool           `json:"wait,omitempty" yaml:"wait,omitempty"`
+		Timeout  string          `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	}
+
+	// containerParams are parameters of the new container
+	// from command flags or spec file.
+	containerParams struct {
+		rule     *netmap.PlacementRule
+		capacity uint64
+		basicACL uint32
+
+		// eacl is set only if spec contains extended ACL table
+		eacl []byte
+
+		wait    bool
+		timeout time.Duration
+	}
+)
+
+const (
+	specFlag   = "spec"
+	dryRunFlag = "dry-run"
+
+	defaultBasicACL = "private"
+
+	driftFieldPlacement = "placement"
+	driftFieldCapacity  = "capacity"
+	driftFieldBasicACL  = "basic_acl"
+	driftFieldEACL      = "eacl"
+)
+
+// readContainerSpec reads container spec from yaml or json file.
+func readContainerSpec(path string) (*containerSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read spec file %s", path)
+	}
+
+	spec := new(containerSpec)
+
+	if eaclFormatFromPath(path) == eaclFormatJSON {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+
+		err = dec.Decode(spec)
+	} else {
+		err = yaml.UnmarshalStrict(data, spec)
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse spec file %s", path)
+	}
+
+	return spec, nil
+}
+
+// containerParamsFromSpec validates spec and converts it into container parameters.
+func containerParamsFromSpec(spec *containerSpec) (*containerParams, error) {
+	var (
+		err error
+		prm = &containerParams{
+			capacity: spec.Capacity,
+			wait:     spec.Wait == nil || *spec.Wait,
+		}
+	)
+
+	if spec.Rule == "" {
+		return nil, errors.New("placement rule is not set in spec")
+	} else if prm.rule, err = query.ParseQuery(spec.Rule); err != nil {
+		return nil, errors.Wrapf(err, "placement rule parse failed %s", spec.Rule)
+	}
+
+	if prm.capacity == 0 {
+		prm.capacity = defaultCapacity
+	}
+
+	if spec.ACL == "" {
+		spec.ACL = defaultBasicACL
+	}
+
+	if prm.basicACL, err = parseBasicACL(spec.ACL); err != nil {
+		return nil, err
+	}
+
+	if spec.EACL != nil {
+		if prm.eacl, err = compileEACLTable(*spec.EACL); err != nil {
+			return nil, errors.Wrap(err, "could not compile extended ACL")
+		} else if !prm.wait {
+			return nil, errors.New("extended ACL can be set only if container creation is awaited")
+		}
+	}
+
+	if spec.Timeout != "" {
+		if prm.timeout, err = time.ParseDuration(spec.Timeout); err != nil {
+			return nil, errors.Wrapf(err, "could not parse timeout %s", spec.Timeout)
+		}
+	}
+
+	return prm, nil
+}
+
+// containerDrift compares fields of the container with the spec. Extended
+// ACL table is compared only if spec contains it, empty live table means
+// that extended ACL of the container is not set.
+func containerDrift(prm *containerParams, cnr *container.Container, eacl []byte) []containerDriftOutput {
+	var (
+		res = make([]containerDriftOutput, 0)
+
+		specCap = prm.capacity * uint64(object.UnitsGB)
+	)
+
+	if spec, live := placementStringify(prm.rule), placementStringify(&cnr.Rules); spec != live {
+		res = append(res, containerDriftOutput{Field: driftFieldPlacement, Spec: spec, Live: live})
+	}
+
+	if specCap != cnr.Capacity {
+		res = append(res, containerDriftOutput{
+			Field: driftFieldCapacity,
+			Spec:  object.ByteSize(specCap).String(),
+			Live:  object.ByteSize(cnr.Capacity).String(),
+		})
+	}
+
+	if prm.basicACL != cnr.BasicACL {
+		res = append(res, containerDriftOutput{
+			Field: driftFieldBasicACL,
+			Spec:  fmt.Sprintf("%08x", prm.basicACL),
+			Live:  fmt.Sprintf("%08x", cnr.BasicACL),
+		})
+	}
+
+	if prm.eacl != nil && !bytes.Equal(prm.eacl, eacl) {
+		res = append(res, containerDriftOutput{
+			Field: driftFieldEACL,
+			Spec:  hex.EncodeToString(prm.eacl),
+			Live:  hex.EncodeToString(eacl),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/container"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readContainerSpec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spec")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	yamlPath := filepath.Join(dir, "container.yml")
+	require.NoError(t, ioutil.WriteFile(yamlPath, []byte(`rule: SELECT 2 Node
+capacity: 5
+acl: readonly
+timeout: 30s
+eacl:
+  records:
+  - operation: put
+    action: deny
+    targets:
+    - role: others
+`), 0600))
+
+	spec, err := readContainerSpec(yamlPath)
+	require.NoError(t, err)
+
+	prm, err := containerParamsFromSpec(spec)
+	require.NoError(t, err)
+	require.Equal(t, "RF 2 SELECT 2 Node", placementStringify(prm.rule))
+	require.Equal(t, uint64(5), prm.capacity)
+	require.Equal(t, uint32(readonlyContainerACLRule), prm.basicACL)
+	require.Equal(t, 30*time.Second, prm.timeout)
+	require.True(t, prm.wait)
+	require.NotEmpty(t, prm.eacl)
+
+	jsonPath := filepath.Join(dir, "container.json")
+	require.NoError(t, ioutil.WriteFile(jsonPath, []byte(`{"rule": "SELECT 3 Node", "wait": false}`), 0600))
+
+	spec, err = readContainerSpec(jsonPath)
+	require.NoError(t, err)
+
+	prm, err = containerParamsFromSpec(spec)
+	require.NoError(t, err)
+	require.Equal(t, uint64(defaultCapacity), prm.capacity)
+	require.Equal(t, uint32(privateContainerACLRule), prm.basicACL)
+	require.False(t, prm.wait)
+	require.Nil(t, prm.eacl)
+
+	t.Run("unknown field", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(yamlPath, []byte("rule: SELECT 2 Node\nreplicas: 3\n"), 0600))
+
+		_, err := readContainerSpec(yamlPath)
+		require.Error(t, err)
+
+		require.NoError(t, ioutil.WriteFile(jsonPath, []byte(`{"rule": "SELECT 2 Node", "replicas": 3}`), 0600))
+
+		_, err = readContainerSpec(jsonPath)
+		require.Error(t, err)
+	})
+}
+
+func Test_containerParamsFromSpec(t *testing.T) {
+	noWait := false
+
+	for name, spec := range map[string]containerSpec{
+		"no rule":     {ACL: "public"},
+		"bad rule":    {Rule: "SELECT"},
+		"bad acl":     {Rule: "SELECT 2 Node", ACL: "all"},
+		"bad timeout": {Rule: "SELECT 2 Node", Timeout: "soon"},
+		"bad eacl":    {Rule: "SELECT 2 Node", EACL: &eaclRulesTable{Records: []eaclRulesRecord{{Operation: "get"}}}},
+		"eacl without wait": {
+			Rule: "SELECT 2 Node",
+			Wait: &noWait,
+			EACL: &eaclRulesTable{Records: []eaclRulesRecord{{
+				Operation: "get",
+				Action:    "deny",
+				Targets:   []eaclRulesTarget{{Role: "others"}},
+			}}},
+		},
+	} {
+		spec := spec
+		_, err := containerParamsFromSpec(&spec)
+		require.Error(t, err, name)
+	}
+}
+
+func Test_containerDrift(t *testing.T) {
+	prm, err := containerParamsFromSpec(&containerSpec{Rule: "SELECT 2 Node", Capacity: 2, ACL: "public"})
+	require.NoError(t, err)
+
+	cnr := &container.Container{
+		Rules:    *prm.rule,
+		Capacity: 2 * uint64(object.UnitsGB),
+		BasicACL: publicContainerACLRule,
+	}
+
+	require.Empty(t, containerDrift(prm, cnr, nil))
+
+	prm.capacity = 3
+	prm.basicACL = privateContainerACLRule
+
+	drift := containerDrift(prm, cnr, nil)
+	require.Len(t, drift, 2)
+	require.Equal(t, driftFieldCapacity, drift[0].Field)
+	require.Equal(t, driftFieldBasicACL, drift[1].Field)
+	require.Equal(t, "18888888", drift[1].Spec)
+	require.Equal(t, "1fffffff", drift[1].Live)
+
+	t.Run("extended ACL", func(t *testing.T) {
+		prm, err := containerParamsFromSpec(&containerSpec{
+			Rule: "SELECT 2 Node",
+			EACL: &eaclRulesTable{Records: []eaclRulesRecord{{
+				Operation: "get",
+				Action:    "deny",
+				Targets:   []eaclRulesTarget{{Role: "others"}},
+			}}},
+		})
+		require.NoError(t, err)
+
+		cnr := &container.Container{
+			Rules:    *prm.rule,
+			Capacity: defaultCapacity * uint64(object.UnitsGB),
+			BasicACL: privateContainerACLRule,
+		}
+
+		require.Empty(t, containerDrift(prm, cnr, prm.eacl))
+
+		// extended ACL of the container is not set
+		drift := containerDrift(prm, cnr, nil)
+		require.Len(t, drift, 1)
+		require.Equal(t, driftFieldEACL, drift[0].Field)
+		require.Equal(t, hex.EncodeToString(prm.eacl), drift[0].Spec)
+		require.Empty(t, drift[0].Live)
+	})
+}
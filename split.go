@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/nspcc-dev/neofs-api-go/service"
+	"github.com/pkg/errors"
+)
+
+func linkHeader(t object.Link_Type, id refs.ObjectID) *object.Header {
+	return &object.Header{Value: &object.Header_Link{
+		Link: &object.Link{Type: t, ID: id},
+	}}
+}
+
+func splitHeader() *object.Header {
+	return &object.Header{Value: &object.Header_Transform{
+		Transform: &object.Transform{Type: object.Transform_Split},
+	}}
+}
+
+// splitObject creates child objects that contain parts of the root object
+// payload of maxSize bytes at most. Children are linked with each other
+// and with the root object, which keeps user headers and has no payload.
+func splitObject(root *object.Object, maxSize uint64) ([]*object.Object, error) {
+	var (
+		size = root.SystemHeader.PayloadLength
+		num  = (size + maxSize - 1) / maxSize
+	)
+
+	children := make([]*object.Object, num)
+
+	for i := range children {
+		id, err := refs.NewObjectID()
+		if err != nil {
+			return nil, errors.Wrap(err, "can't generate new object ID")
+		}
+
+		length := maxSize
+		if i == len(children)-1 {
+			length = size - maxSize*(num-1)
+		}
+
+		children[i] = &object.Object{
+			SystemHeader: object.SystemHeader{
+				ID:            id,
+				OwnerID:       root.SystemHeader.OwnerID,
+				CID:           root.SystemHeader.CID,
+				PayloadLength: length,
+			},
+		}
+	}
+
+	for i, child := range children {
+		child.AddHeader(linkHeader(object.Link_Parent, root.SystemHeader.ID))
+
+		if i > 0 {
+			child.AddHeader(linkHeader(object.Link_Previous, children[i-1].SystemHeader.ID))
+		}
+
+		if i < len(children)-1 {
+			child.AddHeader(linkHeader(object.Link_Next, children[i+1].SystemHeader.ID))
+		}
+
+		child.AddHeader(splitHeader())
+
+		root.AddHeader(linkHeader(object.Link_Child, child.SystemHeader.ID))
+	}
+
+	root.AddHeader(splitHeader())
+
+	return children, nil
+}
+
+// putSplitFile stores file payload in child objects and then stores
+// the root object that links them.
+func putSplitFile(p putParams, root *object.Object, fd *os.File, fPath string, maxSize int64) (*storedObjectOutput, error) {
+	children, err := splitObject(root, uint64(maxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tokens == nil {
+		p.tokens = newTokenCache()
+	}
+
+	fmt.Fprintf(os.Stderr, "[%s] Payload is larger than %s, splitting into %d objects\n",
+		fPath, object.ByteSize(maxSize), len(children))
+
+	var offset int64
+
+	for i, child := range children {
+		var (
+			label  = fmt.Sprintf("%s part %d/%d", fPath, i+1, len(children))
+			length = int64(child.SystemHeader.PayloadLength)
+		)
+
+		addr, h, err := putObject(p, child, io.NewSectionReader(fd, offset, length), label)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not store part %d", i+1)
+		}
+
+		if p.cmd.Bool(verifyFlag) {
+			result, err := verifyObjectHash(p.connectionParams, *addr, child.SystemHeader.PayloadLength, h)
+			if err != nil {
+				return nil, err
+			}
+
+			fmt.Fprintf(os.Stderr, "[%s] Verification result: %s.\n", label, result)
+		}
+
+		offset += length
+	}
+
+	// payload of the root object is stored in children
+	addr, _, err := putObject(p, root, bytes.NewReader(nil), fPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not store root object")
+	}
+
+	return &storedObjectOutput{
+		File:  fPath,
+		CID:   addr.CID.String(),
+		OID:   addr.ObjectID.String(),
+		Parts: len(children),
+	}, nil
+}
+
+// checkSplitLinks checks that i-th child of the root object
+// is linked with the root object and its neighbours.
+func checkSplitLinks(root *object.Object, i int, child *object.Object) error {
+	var (
+		ids   = root.Links(object.Link_Child)
+		links = []struct {
+			t   object.Link_Type
+			ids []refs.ObjectID
+		}{
+			{t: object.Link_Parent, ids: []refs.ObjectID{root.SystemHeader.ID}},
+			{t: object.Link_Previous},
+			{t: object.Link_Next},
+		}
+	)
+
+	if i >= len(ids) {
+		return errors.Errorf("root object %s has no child %d", root.SystemHeader.ID, i)
+	} else if child.SystemHeader.ID != ids[i] {
+		return errors.Errorf("expected child object %s, received %s", ids[i], child.SystemHeader.ID)
+	}
+
+	if i > 0 {
+		links[1].ids = ids[i-1 : i]
+	}
+
+	if i < len(ids)-1 {
+		links[2].ids = ids[i+1 : i+2]
+	}
+
+	for _, link := range links {
+		res := child.Links(link.t)
+		if len(res) != len(link.ids) || (len(res) > 0 && res[0] != link.ids[0]) {
+			return errors.Errorf("child object %s has invalid %s link", child.SystemHeader.ID, link.t)
+		}
+	}
+
+	return nil
+}
+
+// getChildObject receives child object of the split object with payload.
+func getChildObject(p connectionParams, addr refs.Address, maxSize uint64) (*object.Object, error) {
+	c := p.cmd
+
+	token, err := createToken(tokenParams{
+		connectionParams: p,
+
+		addr: addr,
+
+		verb: service.Token_Info_Get,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create session token")
+	}
+
+	req := &object.GetRequest{
+		Address: addr,
+	}
+	req.SetToken(token)
+
+	if err := addBearerToken(c, &req.RequestVerificationHeader); err != nil {
+		return nil, errors.Wrap(err, "could not attach Bearer token")
+	}
+
+	req.SetHeaders(parseRequestHeaders(c.StringSlice(extHdrFlag)))
+	setTTL(c, req)
+	setRaw(c, req)
+	signRequest(c, req)
+
+	getClient, err := object.NewServiceClient(p.conn).Get(p.ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "get command failed on client creation")
+	}
+
+	resp, err := object.ReceiveGetResponse(getClient, maxSize)
+	if err == io.EOF {
+		return nil, errors.Errorf("child object %s not received", addr.ObjectID)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "could not receive child object %s", addr.ObjectID)
+	}
+
+	return resp.GetObject(), nil
+}
+
+// getSplitObject receives children of the split object in order,
+// verifies them unless --no-verify is set and writes their payloads
+// into the file.
+func getSplitObject(p connectionParams, root *object.Object, fPath string, progress bool, log io.Writer) (err error) {
+	var (
+		fd      *os.File
+		written uint64
+
+		ids    = root.Links(object.Link_Child)
+		size   = root.SystemHeader.PayloadLength
+		verify = !p.cmd.Bool(noVerifyFlag)
+	)
+
+	if p.tokens == nil {
+		p.tokens = newTokenCache()
+	}
+
+	if fPath == stdPath {
+		fd = os.Stdout
+	} else if fd, err = os.OpenFile(fPath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, os.FileMode(p.cmd.Int(permFlag))); err != nil {
+		return errors.Wrapf(err, "can't open file %s", fPath)
+	} else {
+		defer func() {
+			fd.Close()
+
+			// split object can't be resumed, so partial payload is dropped
+			dropPartialFile(log, fPath, err)
+		}()
+	}
+
+	if progress {
+		fmt.Fprintf(log, "Object origin received: %s\nreceiving %d linked objects: ", root.SystemHeader.ID, len(ids))
+	}
+
+	for i := range ids {
+		child, err := getChildObject(p, refs.Address{ObjectID: ids[i], CID: root.SystemHeader.CID}, size-written)
+		if err != nil {
+			return err
+		} else if err := checkSplitLinks(root, i, child); err != nil {
+			return errors.Wrapf(errPayloadVerification, "%s", err)
+		} else if verify {
+			if err := child.Verify(); err != nil {
+				return errors.Wrapf(errPayloadVerification, "child object %s: %s", ids[i], err)
+			}
+		}
+
+		if _, err := fd.Write(child.Payload); err != nil {
+			return errors.Wrap(err, "get command failed on file write")
+		}
+
+		written += uint64(len(child.Payload))
+
+		if progress {
+			fmt.Fprint(log, "#")
+		}
+	}
+
+	if written != size {
+		return errors.Wrapf(errPayloadVerification, "payload size of linked objects mismatch (%d != %d)", written, size)
+	}
+
+	if progress {
+		fmt.Fprintln(log, "\nObject successfully fetched")
+	} else {
+		fmt.Fprintf(log, "[%s] Object successfully fetched\n", root.SystemHeader.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/stretchr/testify/require"
+)
+
+func newRootObject(t *testing.T, size uint64) *object.Object {
+	id, err := refs.NewObjectID()
+	require.NoError(t, err)
+
+	return &object.Object{
+		SystemHeader: object.SystemHeader{
+			ID:            id,
+			PayloadLength: size,
+		},
+		Headers: parseUserHeaders([]string{"FileName=a.txt"}),
+	}
+}
+
+func Test_splitObject(t *testing.T) {
+	root := newRootObject(t, 25)
+
+	children, err := splitObject(root, 10)
+	require.NoError(t, err)
+	require.Len(t, children, 3)
+
+	require.True(t, root.IsLinking())
+
+	var size uint64
+
+	ids := root.Links(object.Link_Child)
+	require.Len(t, ids, len(children))
+
+	for i, child := range children {
+		require.Equal(t, ids[i], child.SystemHeader.ID)
+		require.NoError(t, checkSplitLinks(root, i, child))
+
+		_, hdr := child.LastHeader(object.HeaderType(object.TransformHdr))
+		require.NotNil(t, hdr)
+		require.Equal(t, object.Transform_Split, hdr.Value.(*object.Header_Transform).Transform.Type)
+
+		// user headers are kept in the root object only
+		_, hdr = child.LastHeader(object.HeaderType(object.UserHdr))
+		require.Nil(t, hdr)
+
+		size += child.SystemHeader.PayloadLength
+	}
+
+	require.Equal(t, []uint64{10, 10, 5}, []uint64{
+		children[0].SystemHeader.PayloadLength,
+		children[1].SystemHeader.PayloadLength,
+		children[2].SystemHeader.PayloadLength,
+	})
+	require.Equal(t, root.SystemHeader.PayloadLength, size)
+
+	t.Run("exact parts", func(t *testing.T) {
+		children, err := splitObject(newRootObject(t, 20), 10)
+		require.NoError(t, err)
+		require.Len(t, children, 2)
+		require.Equal(t, uint64(10), children[1].SystemHeader.PayloadLength)
+	})
+}
+
+func Test_checkSplitLinks(t *testing.T) {
+	root := newRootObject(t, 30)
+
+	children, err := splitObject(root, 10)
+	require.NoError(t, err)
+
+	// wrong order
+	require.Error(t, checkSplitLinks(root, 0, children[1]))
+
+	// foreign parent
+	other := newRootObject(t, 30)
+	require.Error(t, checkSplitLinks(other, 0, children[0]))
+
+	// broken chain
+	children[1].Headers = children[1].Headers[:1]
+	require.Error(t, checkSplitLinks(root, 1, children[1]))
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/nspcc-dev/neofs-api-go/service"
@@ -60,13 +61,15 @@ func changeState(c *cli.Context) error {
 		ctx  = gracefulContext()
 	)
 
-	switch st := c.String(stateFlag); st {
+	out := changeStateOutput{State: c.String(stateFlag)}
+
+	switch out.State {
 	case "online":
 		req.State = state.ChangeStateRequest_Online
 	case "offline":
 		req.State = state.ChangeStateRequest_Offline
 	default:
-		return errors.Errorf("unknown state: %q", st)
+		return errors.Errorf("unknown state: %q", out.State)
 	}
 
 	if conn, err = connect(ctx, c); err != nil {
@@ -81,9 +84,10 @@ func changeState(c *cli.Context) error {
 		return errors.Wrap(err, "status command failed on remote call")
 	}
 
-	fmt.Println("DONE")
-
-	return nil
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, "DONE")
+		return err
+	})
 }
 
 func getVars(c *cli.Context) error {
@@ -211,9 +215,15 @@ func getHealthy(c *cli.Context) error {
 		return errors.Wrap(err, "status command failed on remote call")
 	}
 
-	fmt.Printf("Healthy: %t\nStatus: %s\n", res.Healthy, res.Status)
+	out := healthOutput{
+		Healthy: res.Healthy,
+		Status:  res.Status,
+	}
 
-	return nil
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Healthy: %t\nStatus: %s\n", out.Healthy, out.Status)
+		return err
+	})
 }
 
 func getEpoch(c *cli.Context) error {
@@ -237,9 +247,10 @@ func getEpoch(c *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "status command failed on remote call")
 	}
-	fmt.Println(nm.Epoch)
-
-	return nil
+	return printOutput(c, epochOutput{Epoch: nm.Epoch}, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, nm.Epoch)
+		return err
+	})
 }
 
 func getNetmap(c *cli.Context) error {
@@ -264,8 +275,10 @@ func getNetmap(c *cli.Context) error {
 		return errors.Wrap(err, "status command failed on remote call")
 	}
 
-	if err := json.NewEncoder(os.Stdout).Encode(nm); err != nil {
-		return errors.Wrap(err, "can't marshall network map to json")
-	}
-	return nil
+	return printOutput(c, netmapOutputFrom(nm), func(w io.Writer) error {
+		if err := json.NewEncoder(w).Encode(nm); err != nil {
+			return errors.Wrap(err, "can't marshall network map to json")
+		}
+		return nil
+	})
 }
@@ -1,15 +1,41 @@
 package main
 
 import (
+	"crypto/rand"
+	"encoding/binary"
 	"fmt"
-
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nspcc-dev/neofs-api-go/hash"
 	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/query"
 	"github.com/nspcc-dev/neofs-api-go/refs"
 	"github.com/nspcc-dev/neofs-api-go/service"
 	"github.com/nspcc-dev/neofs-api-go/storagegroup"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	lifetimeFlag = "lifetime"
+
+	lifetimeUnlimited = "unlimited"
+	lifetimeEpoch     = "epoch"
+	lifetimeUnix      = "unix"
+
+	// sgSaltSize is a size of random salt for range hash requests
+	sgSaltSize = 8
+
+	sgResultPass = "pass"
+	sgResultFail = "fail"
 )
 
 var (
@@ -20,8 +46,6 @@ var (
 		Flags: []cli.Flag{
 			containerID,
 			storagegroupID,
-			oidHidden,
-			fullHeadersHidden,
 		},
 	}
 
@@ -44,7 +68,27 @@ var (
 		Action: putSG,
 		Flags: []cli.Flag{
 			containerID,
-			objectIDs,
+			&cli.StringSliceFlag{
+				Name:  objFlag,
+				Usage: "storage group member IDs, can't be used with search filters",
+			},
+			&cli.StringFlag{
+				Name:  lifetimeFlag,
+				Usage: "storage group lifetime: unlimited, epoch:<number> or unix:<timestamp>",
+			},
+			searchRoot,
+			searchQuery,
+			assumeYes,
+			bearer,
+			bearerFile,
+		},
+	}
+
+	verifySGAction = &action{
+		Action: verifySG,
+		Flags: []cli.Flag{
+			containerID,
+			storagegroupID,
 		},
 	}
 
@@ -67,12 +111,91 @@ func listSG(c *cli.Context) error {
 }
 
 func getSG(c *cli.Context) error {
-	if err := c.Set(objFlag, c.String(sgidFlag)); err != nil {
+	var (
+		err  error
+		cid  refs.CID
+		sgID refs.ObjectID
+		host = getHost(c)
+		conn *grpc.ClientConn
+		ctx  = gracefulContext()
+
+		strContainerID = c.String(cidFlag)
+		strSGID        = c.String(sgidFlag)
+	)
+
+	if strContainerID == "" || strSGID == "" {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if cid, err = refs.CIDFromString(strContainerID); err != nil {
+		return errors.Wrapf(err, "could not parse container id %s", strContainerID)
+	} else if err = sgID.Parse(strSGID); err != nil {
+		return errors.Wrapf(err, "could not parse storage group id %s", strSGID)
+	}
+
+	if conn, err = connect(ctx, c); err != nil {
+		return errors.Wrapf(err, "could not connect to host %s", host)
+	}
+
+	sg, err := headObject(connectionParams{
+		ctx:  ctx,
+		cmd:  c,
+		conn: conn,
+	}, refs.Address{CID: cid, ObjectID: sgID}, true)
+	if err != nil {
+		return err
+	}
+
+	sgInfo, err := sg.StorageGroup()
+	if err != nil {
+		return errors.Wrapf(err, "object %s is not a storage group", sgID)
+	}
+
+	out := sgOutputFrom(sg, sgInfo)
+
+	return printOutput(c, out, func(w io.Writer) error {
+		return displaySG(w, out)
+	})
+}
+
+func sgOutputFrom(sg *object.Object, sgInfo *storagegroup.StorageGroup) sgOutput {
+	members := sg.Links(object.Link_StorageGroup)
+
+	out := sgOutput{
+		ID:       sg.SystemHeader.ID.String(),
+		CID:      sg.SystemHeader.CID.String(),
+		OwnerID:  sg.SystemHeader.OwnerID.String(),
+		Size:     sgInfo.ValidationDataSize,
+		Hash:     sgInfo.ValidationHash.String(),
+		Lifetime: sgLifetimeString(sgInfo.Lifetime),
+		Members:  make([]string, 0, len(members)),
+	}
+
+	for i := range members {
+		out.Members = append(out.Members, members[i].String())
+	}
+
+	return out
+}
+
+func displaySG(w io.Writer, out sgOutput) error {
+	tw := tabwriter.NewWriter(w, 1, 8, 3, ' ', 0)
+
+	if _, err := fmt.Fprintf(tw, "Storage group: %s\nContainer: %s\nOwner: %s\n", out.ID, out.CID, out.OwnerID); err != nil {
+		return err
+	} else if _, err := fmt.Fprintf(tw, "Size: %d\nHash: %s\nLifetime: %s\n", out.Size, out.Hash, out.Lifetime); err != nil {
 		return err
-	} else if err := c.Set(fullHeadersFlag, "true"); err != nil {
+	} else if _, err := fmt.Fprintf(tw, "#\tMEMBER (%d)\n", len(out.Members)); err != nil {
 		return err
 	}
-	return head(c)
+
+	for i := range out.Members {
+		if _, err := fmt.Fprintf(tw, "%d\t%s\n", i+1, out.Members[i]); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
 }
 
 func delSG(c *cli.Context) error {
@@ -94,10 +217,23 @@ func putSG(c *cli.Context) error {
 		ctx            = gracefulContext()
 		strContainerID = c.String(cidFlag)
 		strObjectIDs   = c.StringSlice(objFlag)
+		byFilters      = c.NArg() > 0 || c.String(queryFlag) != "" || c.Bool(rootFlag)
 	)
 
-	if strContainerID == "" || len(strObjectIDs) == 0 {
+	if strContainerID == "" || (len(strObjectIDs) == 0 && !byFilters) {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	} else if len(strObjectIDs) > 0 && byFilters {
+		return errors.Errorf("--%s can't be used with search filters\nUsage: %s", objFlag, c.Command.UsageText)
+	}
+
+	filters, err := searchFilters(c)
+	if err != nil {
+		return err
+	}
+
+	lifetime, err := parseSGLifetime(c.String(lifetimeFlag))
+	if err != nil {
+		return err
 	}
 
 	// Try to parse container id
@@ -115,10 +251,39 @@ func putSG(c *cli.Context) error {
 		oids = append(oids, oid)
 	}
 
+	// storage group members must be sorted
+	sort.Sort(storagegroup.IDList(oids))
+
+	for i := 1; i < len(oids); i++ {
+		if oids[i] == oids[i-1] {
+			return errors.Errorf("object %s is listed twice", oids[i])
+		}
+	}
+
 	if conn, err = connect(ctx, c); err != nil {
 		return errors.Wrapf(err, "could not connect to host %s", host)
 	}
 
+	p := connectionParams{
+		ctx:    ctx,
+		cmd:    c,
+		conn:   conn,
+		tokens: newTokenCache(),
+	}
+
+	if byFilters {
+		if oids, err = searchSGMembers(p, cid, filters); err != nil {
+			return err
+		}
+	}
+
+	sgInfo, err := sgValidationData(p, cid, oids)
+	if err != nil {
+		return err
+	}
+
+	sgInfo.Lifetime = lifetime
+
 	owner, err := refs.NewOwnerID(&key.PublicKey)
 	if err != nil {
 		return errors.Wrap(err, "could not compute owner ID")
@@ -138,7 +303,7 @@ func putSG(c *cli.Context) error {
 		}})
 	}
 
-	sg.SetStorageGroup(new(storagegroup.StorageGroup))
+	sg.SetStorageGroup(sgInfo)
 
 	objID, err := refs.NewObjectID()
 	if err != nil {
@@ -148,11 +313,7 @@ func putSG(c *cli.Context) error {
 	sg.SystemHeader.ID = objID
 
 	token, err := createToken(tokenParams{
-		connectionParams: connectionParams{
-			ctx:  ctx,
-			cmd:  c,
-			conn: conn,
-		},
+		connectionParams: p,
 
 		addr: refs.Address{
 			ObjectID: objID,
@@ -173,6 +334,11 @@ func putSG(c *cli.Context) error {
 
 	req := object.MakePutRequestHeader(sg)
 	req.SetToken(token)
+
+	if err := addBearerToken(c, &req.RequestVerificationHeader); err != nil {
+		return errors.Wrap(err, "could not attach Bearer token")
+	}
+
 	setTTL(c, req)
 	setRaw(c, req)
 	signRequest(c, req)
@@ -181,12 +347,490 @@ func putSG(c *cli.Context) error {
 		return errors.Wrap(err, "storage group put command failed on Send SG origin")
 	}
 
-	resp, err := putClient.CloseAndRecv()
-	if err != nil {
+	if _, err = putClient.CloseAndRecv(); err != nil {
 		return errors.Wrap(err, "storage group put command failed on CloseAndRecv")
 	}
 
-	fmt.Printf("Storage group successfully stored\n\tID: %s\n\tCID: %s\n", resp.Address.ObjectID, resp.Address.CID)
+	out := sgOutputFrom(sg, sgInfo)
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Storage group successfully stored\n\tID: %s\n\tCID: %s\n\tSize: %d\n\tHash: %s\n",
+			out.ID, out.CID, out.Size, out.Hash)
+		return err
+	})
+}
+
+// searchSGMembers finds objects matching the filters and asks
+// user to confirm that they should be included into storage group.
+func searchSGMembers(p connectionParams, cid refs.CID, filters []query.Filter) ([]refs.ObjectID, error) {
+	addrs, err := searchObjects(p, cid, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	oids := sgMemberIDs(addrs)
+	if len(oids) == 0 {
+		return nil, errors.New("no objects match search filters")
+	}
+
+	fmt.Fprintf(os.Stderr, "Found %d object(s) matching search filters\n", len(oids))
+
+	if ok, err := confirm(p.cmd, fmt.Sprintf("Put storage group of %d object(s)?", len(oids))); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, errors.New("storage group put is canceled")
+	}
+
+	return oids, nil
+}
+
+// sgMemberIDs returns sorted unique IDs of the objects, search
+// may return the same object from different nodes.
+func sgMemberIDs(addrs []refs.Address) []refs.ObjectID {
+	res := make([]refs.ObjectID, 0, len(addrs))
+
+	for i := range addrs {
+		res = append(res, addrs[i].ObjectID)
+	}
+
+	sort.Sort(storagegroup.IDList(res))
+
+	for i := 1; i < len(res); i++ {
+		if res[i] == res[i-1] {
+			res = append(res[:i], res[i+1:]...)
+			i--
+		}
+	}
+
+	return res
+}
+
+// parseSGLifetime parses storage group lifetime in form unit:value.
+func parseSGLifetime(s string) (*storagegroup.StorageGroup_Lifetime, error) {
+	if s == "" {
+		return nil, nil
+	} else if s == lifetimeUnlimited {
+		return &storagegroup.StorageGroup_Lifetime{Unit: storagegroup.StorageGroup_Lifetime_Unlimited}, nil
+	}
+
+	var (
+		res   = new(storagegroup.StorageGroup_Lifetime)
+		items = strings.Split(s, ":")
+	)
+
+	if len(items) != 2 {
+		return nil, errors.Errorf("lifetime %q must have form 'unit:value'", s)
+	}
+
+	switch items[0] {
+	case lifetimeEpoch:
+		res.Unit = storagegroup.StorageGroup_Lifetime_NeoFSEpoch
+	case lifetimeUnix:
+		res.Unit = storagegroup.StorageGroup_Lifetime_UnixTime
+	default:
+		return nil, errors.Errorf("unknown lifetime unit %q, expected %s or %s", items[0], lifetimeEpoch, lifetimeUnix)
+	}
+
+	value, err := strconv.ParseUint(items[1], 10, 63)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse lifetime value %q", items[1])
+	}
+
+	res.Value = int64(value)
+
+	return res, nil
+}
+
+// objectHomoHash returns homomorphic hash of the object payload from its header.
+func objectHomoHash(obj *object.Object) (hash.Hash, error) {
+	_, hdr := obj.LastHeader(object.HeaderType(object.HomoHashHdr))
+	if hdr == nil {
+		return hash.Hash{}, errors.Errorf("object %s has no homomorphic hash", obj.SystemHeader.ID)
+	}
+
+	return hdr.Value.(*object.Header_HomoHash).HomoHash, nil
+}
+
+// headSGMember receives header of the storage group member and checks
+// that the member exists and isn't deleted.
+func headSGMember(p connectionParams, addr refs.Address) (*object.Object, error) {
+	obj, err := headObject(p, addr, true)
+	if err != nil {
+		if status.Code(errors.Cause(err)) == codes.NotFound {
+			return nil, errors.Errorf("object %s is missing", addr.ObjectID)
+		}
+
+		return nil, err
+	} else if obj.IsTombstone() {
+		return nil, errors.Errorf("object %s is deleted", addr.ObjectID)
+	}
+
+	return obj, nil
+}
+
+// sgMemberParts returns headers of the objects that keep payload of the member:
+// the member itself or its children if it is split into parts.
+func sgMemberParts(p connectionParams, addr refs.Address) ([]*object.Object, error) {
+	obj, err := headSGMember(p, addr)
+	if err != nil {
+		return nil, err
+	} else if !obj.IsLinking() {
+		return []*object.Object{obj}, nil
+	}
+
+	var (
+		children = obj.Links(object.Link_Child)
+		res      = make([]*object.Object, 0, len(children))
+	)
+
+	for _, id := range children {
+		child, err := headSGMember(p, refs.Address{CID: addr.CID, ObjectID: id})
+		if err != nil {
+			return nil, errors.Wrapf(err, "part of object %s", addr.ObjectID)
+		}
+
+		res = append(res, child)
+	}
+
+	return res, nil
+}
+
+// sgMemberData returns payload length and homomorphic hash of the member.
+// Data of the object split into parts is composed from its children.
+func sgMemberData(p connectionParams, addr refs.Address) (uint64, hash.Hash, error) {
+	parts, err := sgMemberParts(p, addr)
+	if err != nil {
+		return 0, hash.Hash{}, err
+	}
+
+	var (
+		size   uint64
+		hashes = make([]hash.Hash, 0, len(parts))
+	)
+
+	for _, part := range parts {
+		h, err := objectHomoHash(part)
+		if err != nil {
+			return 0, hash.Hash{}, err
+		}
+
+		size += part.SystemHeader.PayloadLength
+		hashes = append(hashes, h)
+	}
+
+	h, err := hash.Concat(hashes)
+
+	return size, h, err
+}
+
+// sgValidationData receives headers of all members and composes validation
+// data of the storage group. All members must exist.
+func sgValidationData(p connectionParams, cid refs.CID, oids []refs.ObjectID) (*storagegroup.StorageGroup, error) {
+	var (
+		failed []string
+		res    = new(storagegroup.StorageGroup)
+		hashes = make([]hash.Hash, 0, len(oids))
+	)
+
+	for i := range oids {
+		size, h, err := sgMemberData(p, refs.Address{CID: cid, ObjectID: oids[i]})
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("- %s: %v", oids[i], err))
+			continue
+		}
+
+		res.ValidationDataSize += size
+		hashes = append(hashes, h)
+	}
+
+	if len(failed) > 0 {
+		return nil, errors.Errorf("%d of %d member(s) can't be included into storage group:\n%s",
+			len(failed), len(oids), strings.Join(failed, "\n"))
+	}
+
+	h, err := hash.Concat(hashes)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't compose validation hash")
+	}
+
+	res.ValidationHash = h
+
+	return res, nil
+}
+
+func sgLifetimeString(lifetime *storagegroup.StorageGroup_Lifetime) string {
+	switch {
+	case lifetime == nil || lifetime.Unit == storagegroup.StorageGroup_Lifetime_Unlimited:
+		return lifetimeUnlimited
+	case lifetime.Unit == storagegroup.StorageGroup_Lifetime_NeoFSEpoch:
+		return fmt.Sprintf("%s:%d", lifetimeEpoch, lifetime.Value)
+	default:
+		return fmt.Sprintf("%s:%d", lifetimeUnix, lifetime.Value)
+	}
+}
+
+// requestRangeHashes receives homomorphic hashes of the object payload ranges
+// XORed with the salt.
+func requestRangeHashes(p connectionParams, addr refs.Address, ranges []object.Range, salt []byte) ([]hash.Hash, error) {
+	c := p.cmd
+
+	token, err := createToken(tokenParams{
+		connectionParams: p,
+
+		addr: addr,
+
+		verb: service.Token_Info_RangeHash,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create session token")
+	}
+
+	req := &object.GetRangeHashRequest{
+		Address: addr,
+		Ranges:  ranges,
+		Salt:    salt,
+	}
+	req.SetToken(token)
+
+	if err := addBearerToken(c, &req.RequestVerificationHeader); err != nil {
+		return nil, errors.Wrap(err, "could not attach Bearer token")
+	}
+
+	setTTL(c, req)
+	setRaw(c, req)
+	signRequest(c, req)
+
+	resp, err := object.NewServiceClient(p.conn).GetRangeHash(p.ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't perform GETRANGEHASH request")
+	} else if len(resp.Hashes) != len(ranges) {
+		return nil, errors.Errorf("expected %d range hashes, received %d", len(ranges), len(resp.Hashes))
+	}
+
+	return resp.Hashes, nil
+}
+
+// sgSplitPoint returns random offset in the payload of the size that is
+// aligned to the salt size, so the salt is applied the same way to the
+// whole payload and to the ranges on both sides of the offset.
+// Zero is returned if payload is too small to be split.
+func sgSplitPoint(size uint64, salt []byte) uint64 {
+	if size == 0 {
+		return 0
+	}
+
+	n := (size - 1) / uint64(len(salt))
+	if n == 0 {
+		return 0
+	}
+
+	return (binary.BigEndian.Uint64(salt)%n + 1) * uint64(len(salt))
+}
+
+// verifyPartHashes checks that homomorphic hashes of the payload ranges
+// received from the network compose the hash from the object header.
+// Node is also asked for hashes of the same ranges with random salt,
+// that must be consistent with each other, so it can't answer without
+// the payload itself. Recomputed hash of the payload is returned.
+func verifyPartHashes(p connectionParams, obj *object.Object) (hash.Hash, error) {
+	var (
+		salt = make([]byte, sgSaltSize)
+		addr = *obj.Address()
+		size = obj.SystemHeader.PayloadLength
+	)
+
+	expected, err := objectHomoHash(obj)
+	if err != nil || size == 0 {
+		return expected, err
+	}
+
+	if _, err := rand.Read(salt); err != nil {
+		return expected, errors.Wrap(err, "can't generate salt")
+	}
+
+	ranges := []object.Range{{Offset: 0, Length: size}}
+
+	if m := sgSplitPoint(size, salt); m > 0 {
+		ranges = []object.Range{{Offset: 0, Length: m}, {Offset: m, Length: size - m}}
+	}
+
+	hashes, err := requestRangeHashes(p, addr, ranges, nil)
+	if err != nil {
+		return expected, err
+	}
+
+	h, err := hash.Concat(hashes)
+	if err != nil {
+		return h, errors.Wrap(err, "can't compose range hashes")
+	} else if !h.Equal(expected) {
+		return h, errors.Errorf("object %s: range hashes don't match homomorphic hash", addr.ObjectID)
+	}
+
+	if len(ranges) == 1 {
+		return h, nil
+	}
+
+	salted, err := requestRangeHashes(p, addr, append([]object.Range{{Offset: 0, Length: size}}, ranges...), salt)
+	if err != nil {
+		return h, err
+	}
+
+	if cat, err := hash.Concat(salted[1:]); err != nil {
+		return h, errors.Wrap(err, "can't compose salted range hashes")
+	} else if !cat.Equal(salted[0]) {
+		return h, errors.Errorf("object %s: salted range hashes are inconsistent", addr.ObjectID)
+	}
+
+	return h, nil
+}
+
+// verifySGMember recomputes payload length and homomorphic hash of the
+// member from range hashes received from the network.
+func verifySGMember(p connectionParams, addr refs.Address) (uint64, hash.Hash, error) {
+	parts, err := sgMemberParts(p, addr)
+	if err != nil {
+		return 0, hash.Hash{}, err
+	}
+
+	var (
+		size   uint64
+		hashes = make([]hash.Hash, 0, len(parts))
+	)
+
+	for _, part := range parts {
+		h, err := verifyPartHashes(p, part)
+		if err != nil {
+			return 0, hash.Hash{}, err
+		}
+
+		size += part.SystemHeader.PayloadLength
+		hashes = append(hashes, h)
+	}
+
+	h, err := hash.Concat(hashes)
+
+	return size, h, err
+}
+
+func verifySG(c *cli.Context) error {
+	var (
+		err  error
+		cid  refs.CID
+		sgID refs.ObjectID
+		host = getHost(c)
+		conn *grpc.ClientConn
+		ctx  = gracefulContext()
+
+		strContainerID = c.String(cidFlag)
+		strSGID        = c.String(sgidFlag)
+	)
+
+	if strContainerID == "" || strSGID == "" {
+		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
+	}
+
+	if cid, err = refs.CIDFromString(strContainerID); err != nil {
+		return errors.Wrapf(err, "could not parse container id %s", strContainerID)
+	} else if err = sgID.Parse(strSGID); err != nil {
+		return errors.Wrapf(err, "could not parse storage group id %s", strSGID)
+	}
+
+	if conn, err = connect(ctx, c); err != nil {
+		return errors.Wrapf(err, "could not connect to host %s", host)
+	}
+
+	p := connectionParams{
+		ctx:    ctx,
+		cmd:    c,
+		conn:   conn,
+		tokens: newTokenCache(),
+	}
+
+	sg, err := headObject(p, refs.Address{CID: cid, ObjectID: sgID}, true)
+	if err != nil {
+		return err
+	}
+
+	sgInfo, err := sg.StorageGroup()
+	if err != nil {
+		return errors.Wrapf(err, "object %s is not a storage group", sgID)
+	}
+
+	var (
+		members = sg.Links(object.Link_StorageGroup)
+		hashes  = make([]hash.Hash, 0, len(members))
+		out     = sgVerifyOutput{
+			ID:           sgID.String(),
+			Lifetime:     sgLifetimeString(sgInfo.Lifetime),
+			ExpectedSize: sgInfo.ValidationDataSize,
+			ExpectedHash: sgInfo.ValidationHash.String(),
+			Members:      make([]sgMemberOutput, 0, len(members)),
+			Passed:       true,
+		}
+	)
+
+	for _, id := range members {
+		member := sgMemberOutput{ID: id.String(), Result: sgResultPass}
+
+		size, h, err := verifySGMember(p, refs.Address{CID: cid, ObjectID: id})
+		if err != nil {
+			member.Result = err.Error()
+			out.Passed = false
+		} else {
+			member.Size = size
+			out.Size += size
+			hashes = append(hashes, h)
+		}
+
+		out.Members = append(out.Members, member)
+	}
+
+	if out.Passed {
+		h, err := hash.Concat(hashes)
+		if err != nil {
+			return errors.Wrap(err, "can't compose validation hash")
+		}
+
+		out.Hash = h.String()
+		out.Passed = out.Size == out.ExpectedSize && h.Equal(sgInfo.ValidationHash)
+	}
+
+	err = printOutput(c, out, func(w io.Writer) error {
+		return displaySGVerify(w, out)
+	})
+	if err != nil {
+		return err
+	} else if !out.Passed {
+		return errors.Wrapf(errPayloadVerification, "storage group %s", sgID)
+	}
 
 	return nil
 }
+
+func displaySGVerify(w io.Writer, out sgVerifyOutput) error {
+	tw := tabwriter.NewWriter(w, 1, 8, 3, ' ', 0)
+
+	if _, err := fmt.Fprintf(tw, "Storage group: %s\nLifetime: %s\n", out.ID, out.Lifetime); err != nil {
+		return err
+	} else if _, err := fmt.Fprintln(tw, "MEMBER\tSIZE\tRESULT"); err != nil {
+		return err
+	}
+
+	for _, m := range out.Members {
+		if _, err := fmt.Fprintf(tw, "%s\t%d\t%s\n", m.ID, m.Size, m.Result); err != nil {
+			return err
+		}
+	}
+
+	result := sgResultPass
+	if !out.Passed {
+		result = sgResultFail
+	}
+
+	if _, err := fmt.Fprintf(tw, "Size: %d (expected %d)\nHash: %s (expected %s)\nResult: %s\n",
+		out.Size, out.ExpectedSize, out.Hash, out.ExpectedHash, result); err != nil {
+		return err
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/hash"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	"github.com/nspcc-dev/neofs-api-go/refs"
+	"github.com/nspcc-dev/neofs-api-go/storagegroup"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSGLifetime(t *testing.T) {
+	lifetime, err := parseSGLifetime("")
+	require.NoError(t, err)
+	require.Nil(t, lifetime)
+
+	for arg, res := range map[string]storagegroup.StorageGroup_Lifetime{
+		"unlimited":       {Unit: storagegroup.StorageGroup_Lifetime_Unlimited},
+		"epoch:100":       {Unit: storagegroup.StorageGroup_Lifetime_NeoFSEpoch, Value: 100},
+		"unix:1600000000": {Unit: storagegroup.StorageGroup_Lifetime_UnixTime, Value: 1600000000},
+	} {
+		lifetime, err := parseSGLifetime(arg)
+		require.NoError(t, err, arg)
+		require.Equal(t, res, *lifetime, arg)
+	}
+
+	for _, arg := range []string{"epoch", "epoch:", "epoch:-1", "block:10", "unix:1:2", "forever"} {
+		_, err := parseSGLifetime(arg)
+		require.Error(t, err, arg)
+	}
+}
+
+func Test_sgMemberIDs(t *testing.T) {
+	var (
+		cid  = refs.CID{1}
+		oids = []refs.ObjectID{{3}, {1}, {2}}
+	)
+
+	addrs := []refs.Address{
+		{CID: cid, ObjectID: oids[0]},
+		{CID: cid, ObjectID: oids[1]},
+		{CID: cid, ObjectID: oids[0]},
+		{CID: cid, ObjectID: oids[2]},
+		{CID: cid, ObjectID: oids[1]},
+	}
+
+	require.Equal(t, []refs.ObjectID{oids[1], oids[2], oids[0]}, sgMemberIDs(addrs))
+	require.Empty(t, sgMemberIDs(nil))
+}
+
+func Test_objectHomoHash(t *testing.T) {
+	obj := new(object.Object)
+
+	_, err := objectHomoHash(obj)
+	require.Error(t, err)
+
+	h := hash.Sum([]byte("payload"))
+	obj.AddHeader(&object.Header{Value: &object.Header_HomoHash{HomoHash: h}})
+
+	res, err := objectHomoHash(obj)
+	require.NoError(t, err)
+	require.Equal(t, h, res)
+}
+
+func Test_sgSplitPoint(t *testing.T) {
+	salt := make([]byte, sgSaltSize)
+
+	for _, size := range []uint64{0, 1, sgSaltSize, sgSaltSize + 1} {
+		if size > sgSaltSize {
+			require.Equal(t, uint64(sgSaltSize), sgSplitPoint(size, salt), size)
+		} else {
+			require.Zero(t, sgSplitPoint(size, salt), size)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		_, err := rand.Read(salt)
+		require.NoError(t, err)
+
+		m := sgSplitPoint(1000, salt)
+		require.True(t, m > 0 && m < 1000, m)
+		require.Zero(t, m%sgSaltSize, m)
+	}
+}
+
+func Test_saltedRangeHashes(t *testing.T) {
+	var (
+		data = make([]byte, 1000)
+		salt = make([]byte, sgSaltSize)
+	)
+
+	rand.Read(data)
+	rand.Read(salt)
+
+	r := bytes.NewReader(data)
+	m := sgSplitPoint(uint64(len(data)), salt)
+
+	whole, err := hashRange(r, object.Range{Offset: 0, Length: uint64(len(data))}, salt, 64)
+	require.NoError(t, err)
+
+	left, err := hashRange(r, object.Range{Offset: 0, Length: m}, salt, 64)
+	require.NoError(t, err)
+
+	right, err := hashRange(r, object.Range{Offset: m, Length: uint64(len(data)) - m}, salt, 64)
+	require.NoError(t, err)
+
+	// salt is applied from the beginning of every range, so hashes of
+	// the ranges compose the hash of the whole payload only if they are
+	// split at the offset aligned to the salt size
+	cat, err := hash.Concat([]hash.Hash{left, right})
+	require.NoError(t, err)
+	require.Equal(t, whole, cat)
+}
+
+func Test_displaySGVerify(t *testing.T) {
+	out := sgVerifyOutput{
+		ID:       "a220d19f-78ca-4574-ac1b-d7b246e929b5",
+		Lifetime: lifetimeUnlimited,
+		Members: []sgMemberOutput{
+			{ID: "e35f3596-2cde-4d3e-b57a-752ed687b79a", Size: 10, Result: sgResultPass},
+			{ID: "79ecc573-92c9-4066-8546-96e16e980700", Result: "object 79ecc573-92c9-4066-8546-96e16e980700 is missing"},
+		},
+		Size:         10,
+		ExpectedSize: 20,
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, displaySGVerify(buf, out))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 8)
+	require.Equal(t, []string{"e35f3596-2cde-4d3e-b57a-752ed687b79a", "10", "pass"}, strings.Fields(lines[3]))
+	require.Equal(t, "Result: fail", lines[7])
+}
+
+func Test_displaySG(t *testing.T) {
+	out := sgOutput{
+		ID:       "a220d19f-78ca-4574-ac1b-d7b246e929b5",
+		Lifetime: lifetimeUnlimited,
+		Size:     20,
+		Members: []string{
+			"e35f3596-2cde-4d3e-b57a-752ed687b79a",
+			"79ecc573-92c9-4066-8546-96e16e980700",
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, displaySG(buf, out))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 9)
+	require.Equal(t, []string{"#", "MEMBER", "(2)"}, strings.Fields(lines[6]))
+	require.Equal(t, []string{"2", "79ecc573-92c9-4066-8546-96e16e980700"}, strings.Fields(lines[8]))
+}
+
+func Test_sgOutputFrom(t *testing.T) {
+	var (
+		oid    = refs.ObjectID{1}
+		member = refs.ObjectID{2}
+		sgInfo = &storagegroup.StorageGroup{ValidationDataSize: 10}
+		sg     = &object.Object{SystemHeader: object.SystemHeader{ID: oid}}
+	)
+
+	sg.AddHeader(&object.Header{Value: &object.Header_Link{
+		Link: &object.Link{Type: object.Link_StorageGroup, ID: member},
+	}})
+	sg.SetStorageGroup(sgInfo)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, writeOutput(buf, outputJSON, sgOutputFrom(sg, sgInfo), nil))
+	require.JSONEq(t, `{
+		"id": "`+oid.String()+`",
+		"cid": "11111111111111111111111111111111",
+		"owner_id": "`+refs.OwnerID{}.String()+`",
+		"size": 10,
+		"hash": "`+sgInfo.ValidationHash.String()+`",
+		"lifetime": "unlimited",
+		"members": ["`+member.String()+`"]
+	}`, buf.String())
+}
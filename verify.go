@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	gohash "hash"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neofs-api-go/hash"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	noVerifyFlag = "no-verify"
+
+	// corruptedSuffix is added to the name of the file
+	// with payload that failed verification.
+	corruptedSuffix = ".corrupted"
+
+	// verificationExitCode is an exit code of received objects
+	// that failed verification.
+	verificationExitCode = 3
+)
+
+// errPayloadVerification is a cause of all errors of received objects
+// that do not match their headers.
+var errPayloadVerification = errors.New("payload verification failed")
+
+// payloadVerifier calculates sha256 checksum and homomorphic hash
+// of the payload written into it.
+type payloadVerifier struct {
+	size uint64
+
+	checksum gohash.Hash
+	homoHash hash.Hash
+}
+
+func newPayloadVerifier() *payloadVerifier {
+	return &payloadVerifier{
+		checksum: sha256.New(),
+		homoHash: hash.Sum(nil),
+	}
+}
+
+func (v *payloadVerifier) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	_, _ = v.checksum.Write(p)
+
+	h, err := hash.Concat([]hash.Hash{v.homoHash, hash.Sum(p)})
+	if err != nil {
+		return 0, err
+	}
+
+	v.homoHash = h
+	v.size += uint64(len(p))
+
+	return len(p), nil
+}
+
+// verify compares written payload with the length, checksum
+// and homomorphic hash headers of the object.
+func (v *payloadVerifier) verify(obj *object.Object) error {
+	if v.size != obj.SystemHeader.PayloadLength {
+		return errors.Wrapf(errPayloadVerification, "payload length mismatch (%d != %d)",
+			v.size, obj.SystemHeader.PayloadLength)
+	}
+
+	_, hdr := obj.LastHeader(object.HeaderType(object.PayloadChecksumHdr))
+	if hdr == nil {
+		if v.size > 0 {
+			return errors.Wrap(errPayloadVerification, "payload checksum header not found")
+		}
+	} else if !bytes.Equal(hdr.Value.(*object.Header_PayloadChecksum).PayloadChecksum, v.checksum.Sum(nil)) {
+		return errors.Wrap(errPayloadVerification, "payload checksum mismatch")
+	}
+
+	if _, hdr = obj.LastHeader(object.HeaderType(object.HomoHashHdr)); hdr != nil &&
+		!hdr.Value.(*object.Header_HomoHash).HomoHash.Equal(v.homoHash) {
+		return errors.Wrap(errPayloadVerification, "homomorphic hash mismatch")
+	}
+
+	return nil
+}
+
+// verifyObjectHeaders checks headers checksum and its signature
+// in the integrity header. Unlike object.Verify it does not require
+// payload, which is received separately.
+func verifyObjectHeaders(obj *object.Object) error {
+	ind, hdr := obj.LastHeader(object.HeaderType(object.IntegrityHdr))
+	if hdr == nil || ind != len(obj.Headers)-1 {
+		return errors.Wrap(errPayloadVerification, "integrity header not found")
+	}
+
+	integrity := hdr.Value.(*object.Header_Integrity).Integrity
+
+	var key []byte
+
+	if _, hdr = obj.LastHeader(object.HeaderType(object.TokenHdr)); hdr != nil {
+		key = hdr.Value.(*object.Header_Token).Token.GetSessionKey()
+	} else if _, hdr = obj.LastHeader(object.HeaderType(object.PublicKeyHdr)); hdr != nil {
+		key = hdr.Value.(*object.Header_PublicKey).PublicKey.Value
+	} else {
+		return errors.Wrap(errPayloadVerification, "public key of integrity signature not found")
+	}
+
+	data, err := obj.SystemHeader.Marshal()
+	if err != nil {
+		return err
+	}
+
+	buf := bytes.NewBuffer(data)
+
+	// integrity header is the last one and it is not a part of the checksum
+	for i := range obj.Headers[:ind] {
+		if data, err = obj.Headers[i].Marshal(); err != nil {
+			return err
+		}
+
+		buf.Write(data)
+	}
+
+	if checksum := sha256.Sum256(buf.Bytes()); !bytes.Equal(checksum[:], integrity.HeadersChecksum) {
+		return errors.Wrap(errPayloadVerification, "headers checksum mismatch")
+	}
+
+	pub := crypto.UnmarshalPublicKey(key)
+	if pub == nil {
+		return errors.Wrap(errPayloadVerification, "invalid public key of integrity signature")
+	} else if err := crypto.Verify(pub, integrity.HeadersChecksum, integrity.ChecksumSignature); err != nil {
+		return errors.Wrap(errPayloadVerification, "invalid integrity signature")
+	}
+
+	return nil
+}
+
+// verifyFilePayload compares payload stored in the file with object headers.
+func verifyFilePayload(fPath string, obj *object.Object) error {
+	fd, err := os.Open(fPath)
+	if err != nil {
+		return errors.Wrapf(err, "can't open file %s", fPath)
+	}
+	defer fd.Close()
+
+	v := newPayloadVerifier()
+	if _, err := io.Copy(v, fd); err != nil {
+		return errors.Wrapf(err, "can't read file %s", fPath)
+	}
+
+	return v.verify(obj)
+}
+
+// quarantineFile moves the file with payload that failed verification
+// aside, so it is not mistaken for the object payload.
+func quarantineFile(log io.Writer, fPath string) {
+	if fPath == stdPath {
+		return
+	}
+
+	qPath := fPath + corruptedSuffix
+	if err := os.Rename(fPath, qPath); err != nil {
+		fmt.Fprintf(log, "could not move corrupted file %s: %s\n", fPath, err)
+		return
+	}
+
+	fmt.Fprintf(log, "Received data moved to %s\n", qPath)
+}
+
+// dropPartialFile cleans up the file after failed receiving: payload that
+// failed verification is quarantined, incomplete payload is removed.
+func dropPartialFile(log io.Writer, fPath string, err error) {
+	switch {
+	case err == nil || fPath == stdPath:
+	case errors.Cause(err) == errPayloadVerification:
+		quarantineFile(log, fPath)
+	default:
+		if err := os.Remove(fPath); err != nil {
+			fmt.Fprintf(log, "could not remove incomplete file %s: %s\n", fPath, err)
+		}
+	}
+}
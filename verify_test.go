@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/hash"
+	"github.com/nspcc-dev/neofs-api-go/object"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newPayloadObject(payload []byte) *object.Object {
+	checksum := sha256.Sum256(payload)
+
+	return &object.Object{
+		SystemHeader: object.SystemHeader{PayloadLength: uint64(len(payload))},
+		Headers: []object.Header{
+			{Value: &object.Header_PayloadChecksum{PayloadChecksum: checksum[:]}},
+			{Value: &object.Header_HomoHash{HomoHash: hash.Sum(payload)}},
+		},
+	}
+}
+
+func Test_payloadVerifier(t *testing.T) {
+	payload := bytes.Repeat([]byte("object payload"), 100)
+
+	write := func(t *testing.T, data []byte) *payloadVerifier {
+		v := newPayloadVerifier()
+
+		// payload is received by chunks
+		for len(data) > 0 {
+			n := 100
+			if n > len(data) {
+				n = len(data)
+			}
+
+			_, err := v.Write(data[:n])
+			require.NoError(t, err)
+
+			data = data[n:]
+		}
+
+		return v
+	}
+
+	obj := newPayloadObject(payload)
+	require.NoError(t, write(t, payload).verify(obj))
+
+	corrupted := append([]byte{}, payload...)
+	corrupted[0]++
+
+	err := write(t, corrupted).verify(obj)
+	require.Error(t, err)
+	require.Equal(t, errPayloadVerification, errors.Cause(err))
+
+	err = write(t, payload[1:]).verify(obj)
+	require.Equal(t, errPayloadVerification, errors.Cause(err))
+
+	t.Run("homomorphic hash", func(t *testing.T) {
+		obj := newPayloadObject(payload)
+		obj.Headers[1].Value = &object.Header_HomoHash{HomoHash: hash.Sum(corrupted)}
+
+		err := write(t, payload).verify(obj)
+		require.EqualError(t, err, "homomorphic hash mismatch: payload verification failed")
+	})
+
+	t.Run("missing checksum", func(t *testing.T) {
+		obj := newPayloadObject(payload)
+		obj.Headers = obj.Headers[1:]
+
+		require.Error(t, write(t, payload).verify(obj))
+
+		// empty payload has nothing to verify
+		require.NoError(t, newPayloadVerifier().verify(&object.Object{}))
+	})
+}
+
+func Test_verifyFilePayload(t *testing.T) {
+	fd, err := ioutil.TempFile("", "neofs-cli")
+	require.NoError(t, err)
+
+	defer os.Remove(fd.Name())
+
+	data := []byte("object payload")
+
+	_, err = fd.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, fd.Close())
+
+	require.NoError(t, verifyFilePayload(fd.Name(), newPayloadObject(data)))
+
+	data[0]++
+	require.Error(t, verifyFilePayload(fd.Name(), newPayloadObject(data)))
+}
+
+func Test_verifyObjectHeaders(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	obj := newPayloadObject([]byte("object payload"))
+	obj.AddHeader(&object.Header{Value: &object.Header_PublicKey{
+		PublicKey: &object.PublicKey{Value: crypto.MarshalPublicKey(&key.PublicKey)},
+	}})
+
+	require.EqualError(t, verifyObjectHeaders(obj), "integrity header not found: payload verification failed")
+
+	buf := new(bytes.Buffer)
+
+	data, err := obj.SystemHeader.Marshal()
+	require.NoError(t, err)
+	buf.Write(data)
+
+	for i := range obj.Headers {
+		data, err := obj.Headers[i].Marshal()
+		require.NoError(t, err)
+		buf.Write(data)
+	}
+
+	checksum := sha256.Sum256(buf.Bytes())
+	integrity := &object.IntegrityHeader{
+		HeadersChecksum:   checksum[:],
+		ChecksumSignature: []byte{1, 2, 3},
+	}
+
+	obj.AddHeader(&object.Header{Value: &object.Header_Integrity{Integrity: integrity}})
+	require.EqualError(t, verifyObjectHeaders(obj), "invalid integrity signature: payload verification failed")
+
+	obj.SystemHeader.PayloadLength++
+	require.EqualError(t, verifyObjectHeaders(obj), "headers checksum mismatch: payload verification failed")
+}
+
+func Test_dropPartialFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "neofs-cli")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	newFile := func(name string) string {
+		fPath := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(fPath, []byte("partial"), 0600))
+
+		return fPath
+	}
+
+	log := new(bytes.Buffer)
+
+	fPath := newFile("complete")
+	dropPartialFile(log, fPath, nil)
+	require.FileExists(t, fPath)
+
+	fPath = newFile("corrupted")
+	dropPartialFile(log, fPath, errors.Wrap(errPayloadVerification, "size mismatch"))
+	require.NoFileExists(t, fPath)
+	require.FileExists(t, fPath+corruptedSuffix)
+
+	fPath = newFile("incomplete")
+	dropPartialFile(log, fPath, errors.New("connection lost"))
+	require.NoFileExists(t, fPath)
+	require.NoFileExists(t, fPath+corruptedSuffix)
+}
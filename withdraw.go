@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
-	"os"
+	"math"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/mr-tron/base58"
@@ -17,6 +19,11 @@ import (
 	"google.golang.org/grpc"
 )
 
+const (
+	gasUnit    = "gas"
+	fixed8Unit = "fixed8"
+)
+
 var (
 	withdrawAction    = &action{}
 	putWithdrawAction = &action{
@@ -53,14 +60,19 @@ func putWithdraw(c *cli.Context) error {
 		msgID refs.MessageID
 
 		ctx         = gracefulContext()
-		amount      = c.Float64(amountFlag)
+		strAmount   = c.String(amountFlag)
 		blockHeight = c.Uint64(heightFlag)
 	)
 
-	if amount == 0 || blockHeight == 0 {
+	if strAmount == "" || blockHeight == 0 {
 		return errors.Errorf("invalid input\nUsage: %s", c.Command.UsageText)
 	}
 
+	dec, err := parseGASAmount(strAmount)
+	if err != nil {
+		return err
+	}
+
 	if conn, err = connect(ctx, c); err != nil {
 		return errors.Wrapf(err, "could not connect to host %s", host)
 	}
@@ -69,14 +81,18 @@ func putWithdraw(c *cli.Context) error {
 		return errors.Wrap(err, "could not create message ID")
 	}
 
-	dec := decimal.ParseFloat(amount)
-	fmt.Printf("Will be used precision: %d\n", decimal.GASPrecision)
-
 	owner, err := refs.NewOwnerID(&key.PublicKey)
 	if err != nil {
 		return errors.Wrap(err, "could not compute owner ID")
 	}
 
+	balance, err := requestBalance(ctx, c, conn, owner)
+	if err != nil {
+		return err
+	} else if err = checkWithdrawalAmount(dec, balance.GetBalance()); err != nil {
+		return err
+	}
+
 	req := &accounting.PutRequest{
 		OwnerID:   owner,
 		Amount:    dec,
@@ -92,15 +108,113 @@ func putWithdraw(c *cli.Context) error {
 		return errors.Wrap(err, "put request failed")
 	}
 
-	fmt.Printf("Withdrawal created: %s\n", resp.ID)
+	out := withdrawalOutput{
+		ID:      resp.ID.String(),
+		OwnerID: owner.String(),
+		Amount:  dec.String(),
+		Height:  blockHeight,
+	}
+
+	return printOutput(c, out, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "Withdrawal of %s GAS created: %s\n", out.Amount, out.ID)
+		return err
+	})
+}
+
+// parseGASAmount parses exact decimal amount of GAS with optional "GAS" suffix
+// or integer amount of fixed8 units with "fixed8" suffix.
+func parseGASAmount(s string) (*decimal.Decimal, error) {
+	var (
+		err   error
+		value int64
+		str   = strings.ToLower(strings.TrimSpace(s))
+	)
+
+	if strings.HasSuffix(str, fixed8Unit) {
+		str = strings.TrimSpace(strings.TrimSuffix(str, fixed8Unit))
+		if str == "" {
+			return nil, errors.Errorf("amount %q has no digits", s)
+		}
+
+		value, err = parseAmountDigits(str, "")
+	} else {
+		str = strings.TrimSpace(strings.TrimSuffix(str, gasUnit))
+
+		items := strings.SplitN(str, ".", 2)
+		if len(items) == 1 {
+			items = append(items, "")
+		}
+
+		if items[0] == "" && items[1] == "" {
+			return nil, errors.Errorf("amount %q has no digits", s)
+		} else if len(items[1]) > decimal.GASPrecision {
+			return nil, errors.Errorf("amount %q has more than %d fractional digits", s, decimal.GASPrecision)
+		}
+
+		value, err = parseAmountDigits(items[0], items[1]+strings.Repeat("0", decimal.GASPrecision-len(items[1])))
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "incorrect amount %q", s)
+	} else if value == 0 {
+		return nil, errors.Errorf("amount %q must be positive", s)
+	}
+
+	return decimal.New(value), nil
+}
+
+// parseAmountDigits parses concatenation of integer and fractional
+// parts that must consist of decimal digits only.
+func parseAmountDigits(integer, fraction string) (int64, error) {
+	for _, r := range integer + fraction {
+		if r < '0' || r > '9' {
+			return 0, errors.Errorf("unexpected character %q", r)
+		}
+	}
+
+	return strconv.ParseInt(integer+fraction, 10, 64)
+}
+
+// checkWithdrawalAmount checks that active balance is enough for withdrawal.
+func checkWithdrawalAmount(amount, balance *decimal.Decimal) error {
+	if balance == nil {
+		balance = decimal.Zero
+	}
+
+	// Decimal comparison ignores precision
+	if amount.GT(rescaleDecimal(balance, decimal.GASPrecision)) {
+		return errors.Errorf("amount %s GAS exceeds active balance %s GAS", amount, balance)
+	}
 
 	return nil
 }
 
+// rescaleDecimal converts decimal to the precision. Extra fractional digits
+// are truncated, values that don't fit are saturated.
+func rescaleDecimal(d *decimal.Decimal, precision uint32) *decimal.Decimal {
+	res := decimal.NewWithPrecision(d.Value, precision)
+
+	for p := d.Precision; p > precision; p-- {
+		res.Value /= 10
+	}
+
+	for p := d.Precision; p < precision; p++ {
+		switch {
+		case res.Value > math.MaxInt64/10:
+			res.Value = math.MaxInt64
+		case res.Value < math.MinInt64/10:
+			res.Value = math.MinInt64
+		default:
+			res.Value *= 10
+		}
+	}
+
+	return res
+}
+
 func getWithdraw(c *cli.Context) error {
 	var (
 		err  error
-		key  = getKey(c)
 		host = getHost(c)
 		conn *grpc.ClientConn
 		wid  = c.String(widFlag)
@@ -115,24 +229,19 @@ func getWithdraw(c *cli.Context) error {
 		return errors.Wrapf(err, "can't connect to host '%s'", host)
 	}
 
-	owner, err := refs.NewOwnerID(&key.PublicKey)
+	cheque, err := fetchCheque(ctx, c, conn, wid)
 	if err != nil {
-		return errors.Wrap(err, "could not compute owner ID")
+		return err
 	}
 
-	req := &accounting.GetRequest{
-		ID:      accounting.ChequeID(wid),
-		OwnerID: owner,
-	}
-	setTTL(c, req)
-	setRaw(c, req)
-	signRequest(c, req)
-	resp, err := accounting.NewWithdrawClient(conn).Get(ctx, req)
+	out, err := withdrawalOutputFrom(cheque)
 	if err != nil {
-		return errors.Wrap(err, "can't perform request")
+		return err
 	}
 
-	return displayWithdrawal(os.Stdout, resp.Withdraw.Payload)
+	return printOutput(c, out, func(w io.Writer) error {
+		return displayWithdrawal(w, cheque)
+	})
 }
 
 func displayWithdrawal(wr io.Writer, data []byte) error {
@@ -259,17 +368,35 @@ func listWithdraw(c *cli.Context) error {
 		return errors.Wrapf(err, "can't complete request")
 	}
 
-	if len(resp.Items) == 0 {
-		fmt.Println("No active withdrawals")
+	out := withdrawalListOutput{
+		Withdrawals: make([]withdrawalOutput, 0, len(resp.Items)),
 	}
 
 	for _, item := range resp.Items {
-		fmt.Println(fmt.Sprintf("amount: %sGAS, height: %d, ID: %s, owner ID: %s",
-			item.Amount,
-			item.Height,
-			item.ID,
-			item.OwnerID))
+		out.Withdrawals = append(out.Withdrawals, withdrawalOutput{
+			ID:      item.ID.String(),
+			OwnerID: item.OwnerID.String(),
+			Amount:  item.Amount.String(),
+			Height:  item.Height,
+		})
 	}
 
-	return nil
+	return printOutput(c, out, func(w io.Writer) error {
+		if len(resp.Items) == 0 {
+			_, err := fmt.Fprintln(w, "No active withdrawals")
+			return err
+		}
+
+		for _, item := range out.Withdrawals {
+			if _, err := fmt.Fprintf(w, "amount: %sGAS, height: %d, ID: %s, owner ID: %s\n",
+				item.Amount,
+				item.Height,
+				item.ID,
+				item.OwnerID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"math"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-api-go/accounting"
@@ -99,3 +100,43 @@ Cheque data:
 		})
 	}
 }
+
+func Test_parseGASAmount(t *testing.T) {
+	for arg, res := range map[string]int64{
+		"1":               1e8,
+		"0.1":             1e7,
+		"0.1GAS":          1e7,
+		"1.5 gas":         15e7,
+		".5":              5e7,
+		"2.":              2e8,
+		"0.00000001":      1,
+		"92233720368":     92233720368e8,
+		"150000000fixed8": 15e7,
+		"1 FIXED8":        1,
+	} {
+		dec, err := parseGASAmount(arg)
+		require.NoError(t, err, arg)
+		require.Equal(t, decimal.New(res), dec, arg)
+	}
+
+	for _, arg := range []string{
+		"", ".", "GAS", "fixed8", "0", "0.0", "-1", "1e8", "1,5",
+		"0.000000001", "1.5fixed8", "92233720369", "1.5 BTC",
+	} {
+		_, err := parseGASAmount(arg)
+		require.Error(t, err, arg)
+	}
+}
+
+func Test_checkWithdrawalAmount(t *testing.T) {
+	require.NoError(t, checkWithdrawalAmount(decimal.New(100), decimal.New(100)))
+	require.Error(t, checkWithdrawalAmount(decimal.New(101), decimal.New(100)))
+	require.Error(t, checkWithdrawalAmount(decimal.New(1), nil))
+
+	// 1 GAS with precision 2 and 12
+	require.NoError(t, checkWithdrawalAmount(decimal.New(1e8), decimal.NewWithPrecision(100, 2)))
+	require.Error(t, checkWithdrawalAmount(decimal.New(1e8+1), decimal.NewWithPrecision(100, 2)))
+	require.NoError(t, checkWithdrawalAmount(decimal.New(1e8), decimal.NewWithPrecision(1e12, 12)))
+	require.Error(t, checkWithdrawalAmount(decimal.New(1e8+1), decimal.NewWithPrecision(1e12, 12)))
+	require.NoError(t, checkWithdrawalAmount(decimal.New(1e8), decimal.NewWithPrecision(math.MaxInt64, 0)))
+}